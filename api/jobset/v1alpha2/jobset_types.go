@@ -16,7 +16,10 @@ package v1alpha2
 
 import (
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 const (
@@ -74,6 +77,38 @@ const (
 	// If a ReplicatedJob is part of a group, then its child jobs and pods have this
 	// label/annotation ranging from 0 to annotations[GroupReplicasKey] - 1
 	JobGroupIndexKey string = "jobset.sigs.k8s.io/job-group-index"
+	// NetworkGroupKey is a label set on Jobs and Pods to the network group of the JobSet, if set.
+	// The headless service shared by the JobSets of a network group selects pods with this label.
+	NetworkGroupKey string = "jobset.sigs.k8s.io/network-group"
+	// InPlaceRestartAttemptKey is an annotation set on worker pods by the agent sidecar when
+	// the InPlaceRestart restart strategy is used. Its value is the in-place restart attempt
+	// of the pod and is read by the JobSet controller to orchestrate group restarts.
+	InPlaceRestartAttemptKey string = "jobset.sigs.k8s.io/in-place-restart-attempt"
+	// FrameworkKey is an annotation set on Pods to the name of the framework preset of their
+	// ReplicatedJob, if any. The pod mutating webhook expands it into the rendezvous environment
+	// variables of the framework.
+	FrameworkKey string = "jobset.sigs.k8s.io/framework"
+	// FrameworkReplicasKey is an annotation set on Pods using a framework preset to the replicas of
+	// the ReplicatedJobs using the same framework when their Job was created, as comma separated
+	// <replicatedJob>=<replicas> pairs. The rendezvous environment variables are derived from it rather
+	// than from the current spec, so that all the pods of a Job agree on the world size after the
+	// JobSet is scaled.
+	FrameworkReplicasKey string = "jobset.sigs.k8s.io/framework-replicas"
+)
+
+// The names of the environment variables injected into the containers of the child Jobs
+// when injectTopologyEnv is enabled.
+const (
+	JobSetNameEnv         string = "JOBSET_NAME"
+	ReplicatedJobNameEnv  string = "REPLICATED_JOB_NAME"
+	JobIndexEnv           string = "JOB_INDEX"
+	JobGlobalIndexEnv     string = "JOB_GLOBAL_INDEX"
+	GlobalReplicasEnv     string = "GLOBAL_REPLICAS"
+	GroupNameEnv          string = "GROUP_NAME"
+	GroupReplicasEnv      string = "GROUP_REPLICAS"
+	GroupJobIndexEnv      string = "GROUP_JOB_INDEX"
+	RestartAttemptEnv     string = "RESTART_ATTEMPT"
+	CoordinatorAddressEnv string = "COORDINATOR_ADDRESS"
 )
 
 type JobSetConditionType string
@@ -90,6 +125,23 @@ const (
 	JobSetStartupPolicyInProgress JobSetConditionType = "StartupPolicyInProgress"
 	// JobSetStartupPolicyCompleted means the StartupPolicy has completed.
 	JobSetStartupPolicyCompleted JobSetConditionType = "StartupPolicyCompleted"
+	// JobSetHeld means the JobSet has been suspended by the SuspendJobSet failure policy action
+	// and is held for inspection until it is resumed.
+	JobSetHeld JobSetConditionType = "Held"
+	// JobSetReady means the number of ready or succeeded child Jobs of every started ReplicatedJob
+	// equals its replicas. Suspended ReplicatedJobs and the ones whose dependencies are not reached
+	// yet are not started.
+	JobSetReady JobSetConditionType = "Ready"
+	// JobSetProgressing means the child Jobs of the JobSet are being created or restarted,
+	// and are not all ready yet.
+	JobSetProgressing JobSetConditionType = "Progressing"
+	// JobSetServiceConflict means a service managed by the JobSet cannot be created or reconciled,
+	// as a service with the same name exists and cannot be adopted by the JobSet.
+	JobSetServiceConflict JobSetConditionType = "ServiceConflict"
+	// JobSetNetworkPolicyConflict means the network policy isolating the pods of the JobSet cannot
+	// be created or reconciled, as a network policy with the same name exists and is not controlled
+	// by the JobSet. The child Jobs of the JobSet are not created while the condition is true.
+	JobSetNetworkPolicyConflict JobSetConditionType = "NetworkPolicyConflict"
 )
 
 // JobSetSpec defines the desired state of JobSet
@@ -135,6 +187,23 @@ type JobSetSpec struct {
 	// +optional
 	Coordinator *Coordinator `json:"coordinator,omitempty"`
 
+	// injectTopologyEnv, when set to true, injects the topology of the JobSet as environment
+	// variables into every container and init container of the child Jobs: JOBSET_NAME,
+	// REPLICATED_JOB_NAME, JOB_INDEX, JOB_GLOBAL_INDEX, GLOBAL_REPLICAS, GROUP_NAME,
+	// GROUP_REPLICAS, GROUP_JOB_INDEX, RESTART_ATTEMPT and, if a coordinator is defined,
+	// COORDINATOR_ADDRESS. Environment variables already defined by a container are not overridden.
+	// It can be overridden per ReplicatedJob.
+	// +optional
+	InjectTopologyEnv *bool `json:"injectTopologyEnv,omitempty"`
+
+	// framework is the distributed training framework preset of the JobSet. When set, the
+	// rendezvous environment variables of the framework (e.g. PET_MASTER_ADDR, PET_NODE_RANK and
+	// PET_NNODES for PyTorch) are injected into the containers of the pods, derived from their indexes and
+	// their hostnames. Environment variables already defined by a container are not overridden.
+	// It requires spec.network.enableDNSHostnames and can be overridden per ReplicatedJob.
+	// +optional
+	Framework *Framework `json:"framework,omitempty"`
+
 	// managedBy is used to indicate the controller or entity that manages a JobSet.
 	// The built-in JobSet controller reconciles JobSets which don't have this
 	// field at all or the field value is the reserved string
@@ -159,6 +228,35 @@ type JobSetSpec struct {
 	// +kubebuilder:validation:Minimum=0
 	// +optional
 	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
+	// activeDeadlineSeconds is the duration in seconds, relative to the first start of the
+	// JobSet, that the JobSet may be active before the system tries to terminate it.
+	// The deadline is not reset when the JobSet is restarted, and the time during which
+	// the JobSet is suspended does not count towards it.
+	// Once reached, the JobSet is failed with reason DeadlineExceeded and its child Jobs are deleted.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+
+	// scale exposes the replicas of one ReplicatedJob through the scale subresource of
+	// the JobSet, so elastic workloads can be resized with `kubectl scale` or by autoscalers.
+	// Updates of scale.replicas are applied to the replicas of the target ReplicatedJob.
+	// +optional
+	Scale *ReplicatedJobScale `json:"scale,omitempty"`
+}
+
+// ReplicatedJobScale defines the ReplicatedJob scaled through the scale subresource of the JobSet.
+type ReplicatedJobScale struct {
+	// replicatedJob is the name of the ReplicatedJob scaled through the scale subresource.
+	// It must be the last ReplicatedJob of the JobSet. The field is immutable.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
+	ReplicatedJob string `json:"replicatedJob"`
+
+	// replicas is the desired number of replicas of the target ReplicatedJob.
+	// Defaults to the replicas of the target ReplicatedJob.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
 }
 
 // JobSetStatus defines the observed state of JobSet
@@ -169,6 +267,10 @@ type JobSetStatus struct {
 	// +listMapKey=type
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 
+	// observedGeneration is the most recent generation of the JobSet observed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
 	// restarts tracks the number of times the JobSet has restarted (i.e. recreated in case of RecreateAll policy).
 	// +optional
 	Restarts int32 `json:"restarts"`
@@ -187,6 +289,139 @@ type JobSetStatus struct {
 	// +listType=map
 	// +listMapKey=name
 	ReplicatedJobsStatus []ReplicatedJobStatus `json:"replicatedJobsStatus,omitempty"`
+
+	// pods is the total number of pods of the current child Jobs of the JobSet,
+	// aggregated over all its ReplicatedJobs.
+	// +optional
+	Pods PodsStatus `json:"pods,omitempty"`
+
+	// previousInPlaceRestartAttempt is the previous in-place restart attempt of the JobSet.
+	// Healthy pods with an in-place restart attempt smaller than or equal to this value
+	// should be restarted in-place.
+	// This is written by the JobSet controller and read by the agent sidecars.
+	// +optional
+	PreviousInPlaceRestartAttempt *int32 `json:"previousInPlaceRestartAttempt,omitempty"`
+
+	// currentInPlaceRestartAttempt is the current in-place restart attempt of the JobSet.
+	// Pods with an in-place restart attempt equal to this value should lift their barrier
+	// to allow the worker containers to start running.
+	// This is written by the JobSet controller and read by the agent sidecars.
+	// +optional
+	CurrentInPlaceRestartAttempt *int32 `json:"currentInPlaceRestartAttempt,omitempty"`
+
+	// nextRestartTime is the time after which the JobSet will be restarted, when
+	// restarts are delayed by the restartBackoff of the failure policy.
+	// +optional
+	NextRestartTime *metav1.Time `json:"nextRestartTime,omitempty"`
+
+	// restartTimes are the times of the restarts of the JobSet counting towards maxRestarts,
+	// kept when restartsWindowSeconds or resetRestartsAfterHealthySeconds is set in the failure policy.
+	// Restarts outside of the restart window are pruned.
+	// +optional
+	// +listType=atomic
+	RestartTimes []metav1.Time `json:"restartTimes,omitempty"`
+
+	// restartHistory records the most recent restarts of the JobSet triggered by its failure policy,
+	// oldest first, so that restarts can be investigated after their events have expired.
+	// At most 10 restarts are kept.
+	// +optional
+	// +listType=atomic
+	RestartHistory []RestartHistoryEntry `json:"restartHistory,omitempty"`
+
+	// jobRestarts tracks the number of times each child Job has been recreated
+	// when the RecreateFailedJobs or InPlaceRestart restart strategy is used.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	JobRestarts []JobRestartStatus `json:"jobRestarts,omitempty"`
+
+	// startTime is the time the JobSet was first started, i.e. first running while not suspended.
+	// It is not reset when the JobSet is restarted.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// currentAttemptStartTime is the time the current attempt of the JobSet was started,
+	// i.e. its startTime for the first attempt, and the time of its last restart otherwise.
+	// Recreating only the failed Jobs with the RecreateFailedJobs or InPlaceRestart restart
+	// strategy does not start a new attempt.
+	// +optional
+	CurrentAttemptStartTime *metav1.Time `json:"currentAttemptStartTime,omitempty"`
+
+	// completionTime is the time the JobSet finished, i.e. was marked Completed or Failed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// suspendedDuration is the cumulative time the JobSet has been suspended since its startTime,
+	// excluding the current suspension, if any.
+	// +optional
+	SuspendedDuration *metav1.Duration `json:"suspendedDuration,omitempty"`
+
+	// suspendedSince is the time the JobSet was last suspended after its startTime.
+	// It is cleared when the JobSet is resumed.
+	// +optional
+	SuspendedSince *metav1.Time `json:"suspendedSince,omitempty"`
+
+	// activeDuration is the time the JobSet was active from its startTime to its completionTime,
+	// excluding the time during which it was suspended. It is set when the JobSet finishes.
+	// +optional
+	ActiveDuration *metav1.Duration `json:"activeDuration,omitempty"`
+
+	// scale is the observed state of the ReplicatedJob scaled through the scale subresource.
+	// +optional
+	Scale *ReplicatedJobScaleStatus `json:"scale,omitempty"`
+}
+
+// ReplicatedJobScaleStatus defines the observed state of the ReplicatedJob scaled through
+// the scale subresource of the JobSet.
+type ReplicatedJobScaleStatus struct {
+	// replicas is the number of child Jobs of the current run of the target ReplicatedJob.
+	Replicas int32 `json:"replicas"`
+
+	// selector is the label selector of the pods of the target ReplicatedJob, in the
+	// serialized form expected by the scale subresource.
+	// +optional
+	Selector string `json:"selector,omitempty"`
+}
+
+// RestartHistoryEntry describes a restart of the JobSet triggered by its failure policy.
+type RestartHistoryEntry struct {
+	// attempt is the restart attempt of the JobSet started by this restart, i.e. the value of
+	// status.restarts after the restart.
+	// When the RecreateFailedJobs or InPlaceRestart restart strategy is used, this is instead the
+	// restart attempt of the recreated job, i.e. its value in status.jobRestarts after the restart.
+	Attempt int32 `json:"attempt"`
+
+	// time is the time the restart was triggered.
+	Time metav1.Time `json:"time"`
+
+	// failedJob is the name of the failed child Job which triggered the restart.
+	// +optional
+	FailedJob string `json:"failedJob,omitempty"`
+
+	// failurePolicyRule is the name of the failure policy rule matching the failed child Job.
+	// It is empty if no rule matched and the default action was applied.
+	// +optional
+	FailurePolicyRule string `json:"failurePolicyRule,omitempty"`
+
+	// action is the failure policy action which triggered the restart.
+	Action FailurePolicyAction `json:"action"`
+
+	// reason is the reason of the failure condition of the failed child Job.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// message is the message of the failure condition of the failed child Job.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// JobRestartStatus defines the number of times a child Job has been recreated.
+type JobRestartStatus struct {
+	// name of the child Job.
+	Name string `json:"name"`
+
+	// restarts is the number of times the child Job has been recreated.
+	Restarts int32 `json:"restarts"`
 }
 
 // ReplicatedJobStatus defines the observed ReplicatedJobs Readiness.
@@ -211,16 +446,74 @@ type ReplicatedJobStatus struct {
 
 	// suspended is the number of child Jobs which are in a suspended state.
 	Suspended int32 `json:"suspended"`
+
+	// pods is the number of pods of the child Jobs of the ReplicatedJob, aggregated from
+	// the status of the child Jobs.
+	// +optional
+	Pods PodsStatus `json:"pods,omitempty"`
+
+	// suspend is true when the ReplicatedJob is suspended by its suspend field.
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// restarts is the number of JobSet restarts triggered by a failed child Job of this ReplicatedJob.
+	// +optional
+	Restarts int32 `json:"restarts,omitempty"`
+
+	// restartsCountTowardsMax is the number of JobSet restarts triggered by a failed child Job of
+	// this ReplicatedJob that count towards its own restart limit, i.e. the maxRestarts of the matching
+	// failure policy rule or its replicatedJobMaxRestarts. Restarts counting towards the maxRestarts
+	// of the JobSet are not included.
+	// +optional
+	RestartsCountTowardsMax int32 `json:"restartsCountTowardsMax,omitempty"`
+
+	// restartTimes are the times of the restarts counting towards restartsCountTowardsMax, kept when
+	// restartsWindowSeconds or resetRestartsAfterHealthySeconds is set in the failure policy.
+	// Restarts outside of the restart window are pruned.
+	// +optional
+	// +listType=atomic
+	RestartTimes []metav1.Time `json:"restartTimes,omitempty"`
+}
+
+// PodsStatus is the number of pods of a set of child Jobs, aggregated from the status of the Jobs.
+type PodsStatus struct {
+	// active is the number of pending or running pods.
+	// +optional
+	Active int32 `json:"active,omitempty"`
+
+	// notReady is the number of active pods which are not ready, i.e. active minus ready.
+	// The status of the child Jobs does not distinguish pending pods from running pods which
+	// have not passed their readiness checks yet, so both are counted here.
+	// +optional
+	NotReady int32 `json:"notReady,omitempty"`
+
+	// ready is the number of active pods which are ready.
+	// +optional
+	Ready int32 `json:"ready,omitempty"`
+
+	// succeeded is the number of succeeded pods.
+	// +optional
+	Succeeded int32 `json:"succeeded,omitempty"`
+
+	// failed is the number of failed pods.
+	// +optional
+	Failed int32 `json:"failed,omitempty"`
 }
 
 // +genclient
 // +k8s:openapi-gen=true
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.scale.replicas,statuspath=.status.scale.replicas,selectorpath=.status.scale.selector
 // +kubebuilder:printcolumn:name="TerminalState",JSONPath=".status.terminalState",type=string,description="Final state of JobSet"
 // +kubebuilder:printcolumn:name="Restarts",JSONPath=".status.restarts",type=string,description="Number of restarts"
 // +kubebuilder:printcolumn:name="Completed",type="string",priority=0,JSONPath=".status.conditions[?(@.type==\"Completed\")].status"
 // +kubebuilder:printcolumn:name="Suspended",type="string",JSONPath=".spec.suspend",description="JobSet suspended"
+// +kubebuilder:printcolumn:name="Started",JSONPath=".status.startTime",type=date,description="Time this JobSet was first started"
+// +kubebuilder:printcolumn:name="Finished",JSONPath=".status.completionTime",type=date,description="Time this JobSet finished"
+// +kubebuilder:printcolumn:name="Duration",JSONPath=".status.activeDuration",type=string,description="Time this JobSet was active until it finished, excluding suspensions"
+// +kubebuilder:printcolumn:name="AttemptStarted",JSONPath=".status.currentAttemptStartTime",type=date,priority=1,description="Time the current attempt of this JobSet was started"
+// +kubebuilder:printcolumn:name="SuspendedFor",JSONPath=".status.suspendedDuration",type=string,priority=1,description="Cumulative time this JobSet has been suspended since it started"
 // +kubebuilder:printcolumn:name="Age",JSONPath=".metadata.creationTimestamp",type=date,description="Time this JobSet was created"
 
 // JobSet is the Schema for the jobsets API
@@ -256,15 +549,21 @@ type ReplicatedJob struct {
 
 	// replicas is the number of jobs that will be created from this ReplicatedJob's template.
 	// Jobs names will be in the format: <jobSet.name>-<spec.replicatedJob.name>-<job-index>
+	// The replicas of the last ReplicatedJob can be updated on a running JobSet. On scale up,
+	// the missing Jobs are created. On scale down, the Jobs with the highest indices are deleted.
+	// The global and group indices and replicas of new Jobs are computed from the current spec,
+	// while existing Jobs keep the values they were created with until they are recreated.
+	// Since global and group indices are assigned in the order of the ReplicatedJobs, the replicas
+	// of the other ReplicatedJobs cannot be updated, as it would shift the indices of the Jobs of
+	// the ReplicatedJobs listed after them.
 	// +kubebuilder:default=1
 	Replicas int32 `json:"replicas,omitempty"`
 
 	// dependsOn is an optional list that specifies the preceding ReplicatedJobs upon which
 	// the current ReplicatedJob depends. If specified, the ReplicatedJob will be created
 	// only after the referenced ReplicatedJobs reach their desired state.
-	// The Order of ReplicatedJobs is defined by their enumeration in the slice.
-	// Note, that the first ReplicatedJob in the slice cannot use the DependsOn API.
-	// Currently, only a single item is supported in the DependsOn list.
+	// A ReplicatedJob can depend on multiple ReplicatedJobs, regardless of their enumeration
+	// in the slice, as long as the dependencies between ReplicatedJobs form a directed acyclic graph.
 	// If JobSet is suspended the all active ReplicatedJobs will be suspended. When JobSet is
 	// resumed the Job sequence starts again.
 	// This API is mutually exclusive with the StartupPolicy API.
@@ -274,15 +573,60 @@ type ReplicatedJob struct {
 	// +listType=map
 	// +listMapKey=name
 	DependsOn []DependsOn `json:"dependsOn,omitempty"`
+
+	// suspend suspends the child Jobs of this ReplicatedJob when set to true, while the
+	// child Jobs of the other ReplicatedJobs keep running. The child Jobs are resumed when
+	// it is set back to false, unless the JobSet itself is suspended.
+	// A suspended ReplicatedJob does not block the startup of the ReplicatedJobs following it
+	// with the InOrder startup policy, but it blocks the ReplicatedJobs depending on it.
+	// +optional
+	Suspend *bool `json:"suspend,omitempty"`
+
+	// network overrides the network configuration of the JobSet for the pods of this ReplicatedJob.
+	// It only has an effect when spec.network.enableDNSHostnames is true.
+	// +optional
+	Network *ReplicatedJobNetwork `json:"network,omitempty"`
+
+	// injectTopologyEnv overrides spec.injectTopologyEnv for the child Jobs of this ReplicatedJob.
+	// +optional
+	InjectTopologyEnv *bool `json:"injectTopologyEnv,omitempty"`
+
+	// framework overrides spec.framework for the pods of this ReplicatedJob. The pods of all the
+	// ReplicatedJobs using the same framework form a single distributed world, ranked in the
+	// order the ReplicatedJobs are listed.
+	// +optional
+	Framework *Framework `json:"framework,omitempty"`
 }
 
-// DependsOn defines the dependency on the previous ReplicatedJob status.
+// ReplicatedJobNetwork defines the network configuration of a single ReplicatedJob.
+type ReplicatedJobNetwork struct {
+	// subdomain is the network subdomain of the pods of this ReplicatedJob. A dedicated
+	// headless service with this name, selecting only the pods of this ReplicatedJob, is created.
+	// Pods will be reachable using the fully qualified pod hostname:
+	// <jobSet.name>-<spec.replicatedJob.name>-<job-index>-<pod-index>.<subdomain>
+	// It must be unique among the ReplicatedJobs and differ from spec.network.subdomain.
+	Subdomain string `json:"subdomain"`
+
+	// publishNotReadyAddresses indicates if DNS records of the pods of this ReplicatedJob
+	// should be published before the pods are ready.
+	// Defaults to spec.network.publishNotReadyAddresses.
+	// +optional
+	PublishNotReadyAddresses *bool `json:"publishNotReadyAddresses,omitempty"` //nolint
+}
+
+// DependsOn defines the dependency on the status of another ReplicatedJob.
 type DependsOn struct {
-	// name of the previous ReplicatedJob.
+	// name of the ReplicatedJob this ReplicatedJob depends on.
 	Name string `json:"name"`
 
-	// status defines the condition for the ReplicatedJob. Only Ready or Complete status can be set.
-	// +kubebuilder:validation:Enum=Ready;Complete
+	// status defines the condition for the ReplicatedJob. Ready, Complete, Finished or Failed status can be set.
+	// A ReplicatedJob depending on the Finished or Failed status of another ReplicatedJob is a cleanup
+	// ReplicatedJob: when a child Job fails, the failure policy is executed only once the cleanup
+	// ReplicatedJobs whose dependencies are reached have finished. Likewise, the JobSet is marked
+	// completed only once these cleanup ReplicatedJobs have finished.
+	// ReplicatedJobs depending on the Failed status are not targeted by a success policy without
+	// targetReplicatedJobs, since they only run if another ReplicatedJob fails.
+	// +kubebuilder:validation:Enum=Ready;Complete;Finished;Failed
 	Status DependsOnStatus `json:"status"`
 }
 
@@ -296,6 +640,13 @@ const (
 	// DependencyComplete means the Succeeded counter
 	// equals the number of child Jobs of the dependant ReplicatedJob.
 	DependencyComplete DependsOnStatus = "Complete"
+
+	// DependencyFinished means the dependant ReplicatedJob is either complete,
+	// or has at least one failed child Job.
+	DependencyFinished DependsOnStatus = "Finished"
+
+	// DependencyFailed means the dependant ReplicatedJob has at least one failed child Job.
+	DependencyFailed DependsOnStatus = "Failed"
 )
 
 type Network struct {
@@ -306,7 +657,7 @@ type Network struct {
 	EnableDNSHostnames *bool `json:"enableDNSHostnames,omitempty"` //nolint
 
 	// subdomain is an explicit choice for a network subdomain name
-	// When set, any replicated job in the set is added to this network.
+	// When set, any replicated job in the set without a network override is added to this network.
 	// Defaults to <jobSet.name> if not set.
 	// +optional
 	Subdomain string `json:"subdomain,omitempty"`
@@ -315,6 +666,65 @@ type Network struct {
 	// Defaults to True.
 	// +optional
 	PublishNotReadyAddresses *bool `json:"publishNotReadyAddresses,omitempty"` //nolint
+
+	// group is the name of a network group shared by multiple JobSets in the same namespace.
+	// The JobSets of a network group share the headless service named after their subdomain,
+	// which selects the pods of all of them, so that their pods can resolve each other.
+	// The shared service is owned by every JobSet of the group and is only garbage collected
+	// once all of them are deleted.
+	// All the JobSets of a network group must set the same subdomain and publishNotReadyAddresses.
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// ports are the named ports exposed by the headless services of the JobSet, for which
+	// DNS SRV records are published.
+	// All the JobSets of a network group must set the same ports.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Ports []NetworkPort `json:"ports,omitempty"`
+
+	// isolation, when set, creates a NetworkPolicy named <jobSetName>-isolation isolating the
+	// pods of the JobSet: they only accept traffic from the pods of the same JobSet, from the pods
+	// of the JobSets of its network group if set, and from the peers and on the ports listed here.
+	// The child Jobs are not created while a NetworkPolicy with the same name not controlled by
+	// the JobSet exists, see the NetworkPolicyConflict condition.
+	// The NetworkPolicy is deleted along with the JobSet.
+	// +optional
+	Isolation *NetworkIsolation `json:"isolation,omitempty"`
+}
+
+// NetworkIsolation defines the exceptions to the isolation of the pods of a JobSet.
+type NetworkIsolation struct {
+	// from are additional peers allowed to send traffic to the pods of the JobSet on any port.
+	// +optional
+	From []networkingv1.NetworkPolicyPeer `json:"from,omitempty"`
+
+	// ports are additional ports of the pods of the JobSet accepting traffic from any peer.
+	// +optional
+	Ports []networkingv1.NetworkPolicyPort `json:"ports,omitempty"`
+}
+
+// NetworkPort defines a named port exposed by a service of the JobSet.
+type NetworkPort struct {
+	// name of the port. It must be an IANA_SVC_NAME and unique within the ports.
+	Name string `json:"name"`
+
+	// port is the port number exposed by the service.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// targetPort is the number or name of the port on the pods.
+	// Defaults to port.
+	// +optional
+	TargetPort *intstr.IntOrString `json:"targetPort,omitempty"`
+
+	// protocol is the IP protocol of the port.
+	// Defaults to TCP.
+	// +kubebuilder:validation:Enum=TCP;UDP;SCTP
+	// +optional
+	Protocol corev1.Protocol `json:"protocol,omitempty"`
 }
 
 // Operator defines the target of a SuccessPolicy or FailurePolicy.
@@ -326,6 +736,9 @@ const (
 
 	// OperatorAny applies to any single job matching the jobSelector.
 	OperatorAny Operator = "Any"
+
+	// OperatorAtLeast applies to at least minSucceeded jobs matching the jobSelector.
+	OperatorAtLeast Operator = "AtLeast"
 )
 
 // FailurePolicyAction defines the action the JobSet controller will take for
@@ -342,20 +755,25 @@ const (
 
 	// Do not count the failure against maxRestarts.
 	RestartJobSetAndIgnoreMaxRestarts FailurePolicyAction = "RestartJobSetAndIgnoreMaxRestarts"
+
+	// Suspend the JobSet and hold it for inspection, keeping the failed Jobs and the restart counters.
+	// Once the JobSet is resumed, it is restarted without counting the failure against maxRestarts.
+	SuspendJobSet FailurePolicyAction = "SuspendJobSet"
 )
 
 // FailurePolicyRule defines a FailurePolicyAction to be executed if a child job
 // fails due to a reason listed in OnJobFailureReasons and a message pattern
 // listed in OnJobFailureMessagePatterns. The rule must match both the job
-// failure reason and the job failure message. The rules are evaluated in
-// order and the first matching rule is executed.
+// failure reason and the job failure message, as well as the OnPodExitCodes
+// and OnPodConditions requirements on the failed pods of the job, if set.
+// The rules are evaluated in order and the first matching rule is executed.
 type FailurePolicyRule struct {
 	// name of the failure policy rule.
 	// The name is defaulted to 'failurePolicyRuleN' where N is the index of the failure policy rule.
 	// The name must match the regular expression "^[A-Za-z]([A-Za-z0-9_,:]*[A-Za-z0-9_])?$".
 	Name string `json:"name"`
 	// action to take if the rule is matched.
-	// +kubebuilder:validation:Enum:=FailJobSet;RestartJobSet;RestartJobSetAndIgnoreMaxRestarts
+	// +kubebuilder:validation:Enum:=FailJobSet;RestartJobSet;RestartJobSetAndIgnoreMaxRestarts;SuspendJobSet
 	Action FailurePolicyAction `json:"action"`
 	// onJobFailureReasons is a list of job failures reasons.
 	// The requirement is satisfied
@@ -374,18 +792,45 @@ type FailurePolicyRule struct {
 	// https://pkg.go.dev/regexp/syntax.
 	// +kubebuilder:validation:UniqueItems:true
 	OnJobFailureMessagePatterns []string `json:"onJobFailureMessagePatterns,omitempty"`
+	// onPodExitCodes is a requirement on the container exit codes of the failed pods of the job.
+	// The requirement is satisfied if at least one container of a failed pod terminated with a
+	// non-zero exit code matching the requirement. If unset, any exit code matches.
+	// +optional
+	OnPodExitCodes *batchv1.PodFailurePolicyOnExitCodesRequirement `json:"onPodExitCodes,omitempty"`
+	// onPodConditions is a requirement on the conditions of the failed pods of the job.
+	// The requirement is satisfied if at least one failed pod has a condition matching
+	// at least one of the patterns. An empty list matches any pod conditions.
+	// +optional
+	// +listType=atomic
+	OnPodConditions []batchv1.PodFailurePolicyOnPodConditionsPattern `json:"onPodConditions,omitempty"`
 	// targetReplicatedJobs are the names of the replicated jobs the operator applies to.
 	// An empty list will apply to all replicatedJobs.
 	// +optional
 	// +listType=atomic
 	TargetReplicatedJobs []string `json:"targetReplicatedJobs,omitempty"`
+	// maxRestarts overrides the limit on the number of restarts of the ReplicatedJob of the
+	// failed Job when the RestartJobSet action of this rule is executed.
+	// It takes precedence over the replicatedJobMaxRestarts of the failure policy.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxRestarts *int32 `json:"maxRestarts,omitempty"`
 }
 
 type FailurePolicy struct {
 	// maxRestarts defines the limit on the number of JobSet restarts.
 	// A restart is achieved by recreating all active child jobs.
+	// Restarts counted towards the limit of a ReplicatedJob, set by replicatedJobMaxRestarts
+	// or by the maxRestarts of a failure policy rule, do not count towards this limit.
 	MaxRestarts int32 `json:"maxRestarts,omitempty"`
 
+	// replicatedJobMaxRestarts overrides maxRestarts for the failures of the given ReplicatedJobs.
+	// The restarts triggered by a failed child Job of these ReplicatedJobs are counted
+	// separately, in the status of the ReplicatedJob.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	ReplicatedJobMaxRestarts []ReplicatedJobMaxRestarts `json:"replicatedJobMaxRestarts,omitempty"`
+
 	// restartStrategy defines the strategy to use when restarting the JobSet.
 	// Defaults to Recreate.
 	// +optional
@@ -397,9 +842,63 @@ type FailurePolicy struct {
 	// and only the first matching rule will be executed.
 	// If no matching rule is found, the RestartJobSet action is applied.
 	Rules []FailurePolicyRule `json:"rules,omitempty"`
+
+	// restartsWindowSeconds defines a sliding window for maxRestarts. If set, only the
+	// restarts of the JobSet within the last restartsWindowSeconds count towards maxRestarts,
+	// so the JobSet fails only if it exceeds maxRestarts restarts within the window.
+	// It also applies to the restart limits of ReplicatedJobs.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	RestartsWindowSeconds *int32 `json:"restartsWindowSeconds,omitempty"`
+
+	// resetRestartsAfterHealthySeconds defines the duration after which the restarts of the JobSet
+	// counting towards maxRestarts are reset, once the JobSet has been Ready for that long.
+	// It also applies to the restart limits of ReplicatedJobs.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	ResetRestartsAfterHealthySeconds *int32 `json:"resetRestartsAfterHealthySeconds,omitempty"`
+
+	// restartBackoff defines the exponential backoff between JobSet restarts.
+	// If unset, the JobSet is restarted immediately.
+	// +optional
+	RestartBackoff *RestartBackoff `json:"restartBackoff,omitempty"`
 }
 
-// +kubebuilder:validation:Enum=Recreate;BlockingRecreate
+// ReplicatedJobMaxRestarts defines the limit on the number of restarts triggered by a ReplicatedJob.
+type ReplicatedJobMaxRestarts struct {
+	// name of the ReplicatedJob.
+	Name string `json:"name"`
+
+	// maxRestarts defines the limit on the number of JobSet restarts triggered by a failed
+	// child Job of the ReplicatedJob.
+	// +kubebuilder:validation:Minimum=0
+	MaxRestarts int32 `json:"maxRestarts"`
+}
+
+// RestartBackoff defines the delay before a JobSet restart, computed as
+// initialDelaySeconds * multiplier^restarts and capped at maxDelaySeconds, where restarts is the
+// number of restarts since the JobSet was last Ready, as recorded in status.restartHistory.
+type RestartBackoff struct {
+	// initialDelaySeconds is the delay before the first restart of the JobSet.
+	// +kubebuilder:validation:Minimum=1
+	InitialDelaySeconds int32 `json:"initialDelaySeconds"`
+
+	// multiplier is the factor by which the delay is multiplied for each restart.
+	// Defaults to 2.
+	// +optional
+	// +kubebuilder:default=2
+	// +kubebuilder:validation:Minimum=1
+	Multiplier int32 `json:"multiplier,omitempty"`
+
+	// maxDelaySeconds is the maximum delay between restarts of the JobSet.
+	// Defaults to 600 (10 minutes).
+	// +optional
+	// +kubebuilder:default=600
+	// +kubebuilder:validation:Minimum=1
+	MaxDelaySeconds int32 `json:"maxDelaySeconds,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Recreate;BlockingRecreate;InPlaceRestart;RecreateFailedJobs
 type JobSetRestartStrategy string
 
 const (
@@ -409,13 +908,35 @@ const (
 	// BlockingRecreate ensures that all Jobs (and Pods) from a previous iteration are deleted before
 	// creating new Jobs.
 	BlockingRecreate JobSetRestartStrategy = "BlockingRecreate"
+
+	// InPlaceRestart restarts healthy Pods in-place and lets the Job controller recreate
+	// failed Pods, skipping the Pod deletion, scheduling and creation steps. When a child Job
+	// has failed and the matching failure policy rule restarts the JobSet, the in-place restart
+	// attempt is bumped so the healthy Pods restart in-place, and only the failed Job is recreated.
+	// Its restarts are counted per Job in .status.jobRestarts, as with RecreateFailedJobs.
+	// Requires the InPlaceRestart feature gate.
+	InPlaceRestart JobSetRestartStrategy = "InPlaceRestart"
+
+	// RecreateFailedJobs deletes and recreates only the failed Job at the same index, leaving
+	// the other Jobs running. Restarts are counted per Job in .status.jobRestarts, and every
+	// recreated Job counts towards the maxRestarts of the JobSet.
+	RecreateFailedJobs JobSetRestartStrategy = "RecreateFailedJobs"
 )
 
 type SuccessPolicy struct {
-	// operator determines either All or Any of the selected jobs should succeed to consider the JobSet successful
-	// +kubebuilder:validation:Enum=All;Any
+	// operator determines either All, Any or AtLeast minSucceeded of the selected jobs should succeed
+	// to consider the JobSet successful
+	// +kubebuilder:validation:Enum=All;Any;AtLeast
 	Operator Operator `json:"operator"`
 
+	// minSucceeded is the number of the selected jobs which should succeed to consider the JobSet
+	// successful when the AtLeast operator is used. It must be set if and only if the operator is AtLeast,
+	// and must not exceed the number of replicas of the target replicated jobs.
+	// Once the JobSet is successful, its remaining active jobs are deleted.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinSucceeded *int32 `json:"minSucceeded,omitempty"`
+
 	// targetReplicatedJobs are the names of the replicated jobs the operator will apply to.
 	// A null or empty list will apply to all replicatedJobs.
 	// +optional
@@ -456,6 +977,57 @@ type Coordinator struct {
 
 	// podIndex is the Job completion index of the coordinator pod.
 	PodIndex int `json:"podIndex,omitempty"`
+
+	// service, when set, creates a ClusterIP service named <jobSet.name>-coordinator selecting
+	// only the coordinator pod, which can be reached by clients outside of the pod network.
+	// The service is deleted along with the JobSet.
+	// +optional
+	Service *CoordinatorService `json:"service,omitempty"`
+}
+
+// CoordinatorService defines the ClusterIP service of the coordinator pod.
+type CoordinatorService struct {
+	// ports are the named ports exposed by the coordinator service.
+	// Defaults to spec.network.ports if not set.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Ports []NetworkPort `json:"ports,omitempty"`
+}
+
+// FrameworkName is the name of a distributed training framework preset.
+// +kubebuilder:validation:Enum=PyTorch;JAX;TensorFlow;MPI
+type FrameworkName string
+
+const (
+	// PyTorch injects MASTER_ADDR and MASTER_PORT, as well as the PET_MASTER_ADDR, PET_MASTER_PORT,
+	// PET_NNODES and PET_NODE_RANK variables read by torchrun. Each pod is a torchrun node, so
+	// PET_NNODES and PET_NODE_RANK are the number of pods and the rank of the pod, not the world
+	// size and rank of the worker processes, which torchrun derives from them and --nproc_per_node.
+	PyTorch FrameworkName = "PyTorch"
+	// JAX injects JAX_COORDINATOR_ADDRESS, JAX_NUM_PROCESSES and JAX_PROCESS_ID.
+	JAX FrameworkName = "JAX"
+	// TensorFlow injects TF_CONFIG, with one task type per ReplicatedJob. The ReplicatedJobs using
+	// TensorFlow are used as the task type of the same name, so they must be named after a
+	// TF_CONFIG task type: chief, worker, ps or evaluator.
+	TensorFlow FrameworkName = "TensorFlow"
+	// MPI injects JOBSET_MPI_HOSTS, the comma separated hostnames of the pods, which is not read by
+	// the MPI implementations but can be passed to them, e.g. with mpirun --host $JOBSET_MPI_HOSTS,
+	// and OMPI_MCA_orte_keep_fqdn_hostnames.
+	MPI FrameworkName = "MPI"
+)
+
+// Framework defines a distributed training framework preset.
+type Framework struct {
+	// name of the framework: PyTorch, JAX, TensorFlow or MPI.
+	Name FrameworkName `json:"name"`
+
+	// port used by the framework for the rendezvous of the pods.
+	// Defaults to 29500 for PyTorch, 1234 for JAX and 2222 for TensorFlow. It is not used by MPI.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port *int32 `json:"port,omitempty"`
 }
 
 func init() {
@@ -24,19 +24,31 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"sigs.k8s.io/jobset/api/jobset/v1alpha2.Coordinator":         schema_jobset_api_jobset_v1alpha2_Coordinator(ref),
-		"sigs.k8s.io/jobset/api/jobset/v1alpha2.DependsOn":           schema_jobset_api_jobset_v1alpha2_DependsOn(ref),
-		"sigs.k8s.io/jobset/api/jobset/v1alpha2.FailurePolicy":       schema_jobset_api_jobset_v1alpha2_FailurePolicy(ref),
-		"sigs.k8s.io/jobset/api/jobset/v1alpha2.FailurePolicyRule":   schema_jobset_api_jobset_v1alpha2_FailurePolicyRule(ref),
-		"sigs.k8s.io/jobset/api/jobset/v1alpha2.JobSet":              schema_jobset_api_jobset_v1alpha2_JobSet(ref),
-		"sigs.k8s.io/jobset/api/jobset/v1alpha2.JobSetList":          schema_jobset_api_jobset_v1alpha2_JobSetList(ref),
-		"sigs.k8s.io/jobset/api/jobset/v1alpha2.JobSetSpec":          schema_jobset_api_jobset_v1alpha2_JobSetSpec(ref),
-		"sigs.k8s.io/jobset/api/jobset/v1alpha2.JobSetStatus":        schema_jobset_api_jobset_v1alpha2_JobSetStatus(ref),
-		"sigs.k8s.io/jobset/api/jobset/v1alpha2.Network":             schema_jobset_api_jobset_v1alpha2_Network(ref),
-		"sigs.k8s.io/jobset/api/jobset/v1alpha2.ReplicatedJob":       schema_jobset_api_jobset_v1alpha2_ReplicatedJob(ref),
-		"sigs.k8s.io/jobset/api/jobset/v1alpha2.ReplicatedJobStatus": schema_jobset_api_jobset_v1alpha2_ReplicatedJobStatus(ref),
-		"sigs.k8s.io/jobset/api/jobset/v1alpha2.StartupPolicy":       schema_jobset_api_jobset_v1alpha2_StartupPolicy(ref),
-		"sigs.k8s.io/jobset/api/jobset/v1alpha2.SuccessPolicy":       schema_jobset_api_jobset_v1alpha2_SuccessPolicy(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.Coordinator":              schema_jobset_api_jobset_v1alpha2_Coordinator(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.CoordinatorService":       schema_jobset_api_jobset_v1alpha2_CoordinatorService(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.DependsOn":                schema_jobset_api_jobset_v1alpha2_DependsOn(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.FailurePolicy":            schema_jobset_api_jobset_v1alpha2_FailurePolicy(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.FailurePolicyRule":        schema_jobset_api_jobset_v1alpha2_FailurePolicyRule(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.Framework":                schema_jobset_api_jobset_v1alpha2_Framework(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.JobRestartStatus":         schema_jobset_api_jobset_v1alpha2_JobRestartStatus(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.JobSet":                   schema_jobset_api_jobset_v1alpha2_JobSet(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.JobSetList":               schema_jobset_api_jobset_v1alpha2_JobSetList(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.JobSetSpec":               schema_jobset_api_jobset_v1alpha2_JobSetSpec(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.JobSetStatus":             schema_jobset_api_jobset_v1alpha2_JobSetStatus(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.Network":                  schema_jobset_api_jobset_v1alpha2_Network(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.NetworkIsolation":         schema_jobset_api_jobset_v1alpha2_NetworkIsolation(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.NetworkPort":              schema_jobset_api_jobset_v1alpha2_NetworkPort(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.PodsStatus":               schema_jobset_api_jobset_v1alpha2_PodsStatus(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.ReplicatedJob":            schema_jobset_api_jobset_v1alpha2_ReplicatedJob(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.ReplicatedJobMaxRestarts": schema_jobset_api_jobset_v1alpha2_ReplicatedJobMaxRestarts(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.ReplicatedJobNetwork":     schema_jobset_api_jobset_v1alpha2_ReplicatedJobNetwork(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.ReplicatedJobScale":       schema_jobset_api_jobset_v1alpha2_ReplicatedJobScale(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.ReplicatedJobScaleStatus": schema_jobset_api_jobset_v1alpha2_ReplicatedJobScaleStatus(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.ReplicatedJobStatus":      schema_jobset_api_jobset_v1alpha2_ReplicatedJobStatus(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.RestartBackoff":           schema_jobset_api_jobset_v1alpha2_RestartBackoff(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.RestartHistoryEntry":      schema_jobset_api_jobset_v1alpha2_RestartHistoryEntry(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.StartupPolicy":            schema_jobset_api_jobset_v1alpha2_StartupPolicy(ref),
+		"sigs.k8s.io/jobset/api/jobset/v1alpha2.SuccessPolicy":            schema_jobset_api_jobset_v1alpha2_SuccessPolicy(ref),
 	}
 }
 
@@ -69,10 +81,55 @@ func schema_jobset_api_jobset_v1alpha2_Coordinator(ref common.ReferenceCallback)
 							Format:      "int32",
 						},
 					},
+					"service": {
+						SchemaProps: spec.SchemaProps{
+							Description: "service, when set, creates a ClusterIP service named <jobSet.name>-coordinator selecting only the coordinator pod, which can be reached by clients outside of the pod network. The service is deleted along with the JobSet.",
+							Ref:         ref("sigs.k8s.io/jobset/api/jobset/v1alpha2.CoordinatorService"),
+						},
+					},
 				},
 				Required: []string{"replicatedJob"},
 			},
 		},
+		Dependencies: []string{
+			"sigs.k8s.io/jobset/api/jobset/v1alpha2.CoordinatorService"},
+	}
+}
+
+func schema_jobset_api_jobset_v1alpha2_CoordinatorService(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CoordinatorService defines the ClusterIP service of the coordinator pod.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ports": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ports are the named ports exposed by the coordinator service. Defaults to spec.network.ports if not set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/jobset/api/jobset/v1alpha2.NetworkPort"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/jobset/api/jobset/v1alpha2.NetworkPort"},
 	}
 }
 
@@ -80,12 +137,12 @@ func schema_jobset_api_jobset_v1alpha2_DependsOn(ref common.ReferenceCallback) c
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DependsOn defines the dependency on the previous ReplicatedJob status.",
+				Description: "DependsOn defines the dependency on the status of another ReplicatedJob.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "name of the previous ReplicatedJob.",
+							Description: "name of the ReplicatedJob this ReplicatedJob depends on.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -93,7 +150,7 @@ func schema_jobset_api_jobset_v1alpha2_DependsOn(ref common.ReferenceCallback) c
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "status defines the condition for the ReplicatedJob. Only Ready or Complete status can be set.",
+							Description: "status defines the condition for the ReplicatedJob. Ready, Complete, Finished or Failed status can be set. A ReplicatedJob depending on the Finished or Failed status of another ReplicatedJob is a cleanup ReplicatedJob: when a child Job fails, the failure policy is executed only once the cleanup ReplicatedJobs whose dependencies are reached have finished. Likewise, the JobSet is marked completed only once these cleanup ReplicatedJobs have finished. ReplicatedJobs depending on the Failed status are not targeted by a success policy without targetReplicatedJobs, since they only run if another ReplicatedJob fails.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -114,11 +171,33 @@ func schema_jobset_api_jobset_v1alpha2_FailurePolicy(ref common.ReferenceCallbac
 				Properties: map[string]spec.Schema{
 					"maxRestarts": {
 						SchemaProps: spec.SchemaProps{
-							Description: "maxRestarts defines the limit on the number of JobSet restarts. A restart is achieved by recreating all active child jobs.",
+							Description: "maxRestarts defines the limit on the number of JobSet restarts. A restart is achieved by recreating all active child jobs. Restarts counted towards the limit of a ReplicatedJob, set by replicatedJobMaxRestarts or by the maxRestarts of a failure policy rule, do not count towards this limit.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
+					"replicatedJobMaxRestarts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "replicatedJobMaxRestarts overrides maxRestarts for the failures of the given ReplicatedJobs. The restarts triggered by a failed child Job of these ReplicatedJobs are counted separately, in the status of the ReplicatedJob.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/jobset/api/jobset/v1alpha2.ReplicatedJobMaxRestarts"),
+									},
+								},
+							},
+						},
+					},
 					"restartStrategy": {
 						SchemaProps: spec.SchemaProps{
 							Description: "restartStrategy defines the strategy to use when restarting the JobSet. Defaults to Recreate.",
@@ -140,11 +219,31 @@ func schema_jobset_api_jobset_v1alpha2_FailurePolicy(ref common.ReferenceCallbac
 							},
 						},
 					},
+					"restartsWindowSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "restartsWindowSeconds defines a sliding window for maxRestarts. If set, only the restarts of the JobSet within the last restartsWindowSeconds count towards maxRestarts, so the JobSet fails only if it exceeds maxRestarts restarts within the window. It also applies to the restart limits of ReplicatedJobs.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"resetRestartsAfterHealthySeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "resetRestartsAfterHealthySeconds defines the duration after which the restarts of the JobSet counting towards maxRestarts are reset, once the JobSet has been Ready for that long. It also applies to the restart limits of ReplicatedJobs.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"restartBackoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "restartBackoff defines the exponential backoff between JobSet restarts. If unset, the JobSet is restarted immediately.",
+							Ref:         ref("sigs.k8s.io/jobset/api/jobset/v1alpha2.RestartBackoff"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/jobset/api/jobset/v1alpha2.FailurePolicyRule"},
+			"sigs.k8s.io/jobset/api/jobset/v1alpha2.FailurePolicyRule", "sigs.k8s.io/jobset/api/jobset/v1alpha2.ReplicatedJobMaxRestarts", "sigs.k8s.io/jobset/api/jobset/v1alpha2.RestartBackoff"},
 	}
 }
 
@@ -152,7 +251,7 @@ func schema_jobset_api_jobset_v1alpha2_FailurePolicyRule(ref common.ReferenceCal
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "FailurePolicyRule defines a FailurePolicyAction to be executed if a child job fails due to a reason listed in OnJobFailureReasons and a message pattern listed in OnJobFailureMessagePatterns. The rule must match both the job failure reason and the job failure message. The rules are evaluated in order and the first matching rule is executed.",
+				Description: "FailurePolicyRule defines a FailurePolicyAction to be executed if a child job fails due to a reason listed in OnJobFailureReasons and a message pattern listed in OnJobFailureMessagePatterns. The rule must match both the job failure reason and the job failure message, as well as the OnPodExitCodes and OnPodConditions requirements on the failed pods of the job, if set. The rules are evaluated in order and the first matching rule is executed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
@@ -201,6 +300,31 @@ func schema_jobset_api_jobset_v1alpha2_FailurePolicyRule(ref common.ReferenceCal
 							},
 						},
 					},
+					"onPodExitCodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "onPodExitCodes is a requirement on the container exit codes of the failed pods of the job. The requirement is satisfied if at least one container of a failed pod terminated with a non-zero exit code matching the requirement. If unset, any exit code matches.",
+							Ref:         ref("k8s.io/api/batch/v1.PodFailurePolicyOnExitCodesRequirement"),
+						},
+					},
+					"onPodConditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "onPodConditions is a requirement on the conditions of the failed pods of the job. The requirement is satisfied if at least one failed pod has a condition matching at least one of the patterns. An empty list matches any pod conditions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/batch/v1.PodFailurePolicyOnPodConditionsPattern"),
+									},
+								},
+							},
+						},
+					},
 					"targetReplicatedJobs": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -221,10 +345,78 @@ func schema_jobset_api_jobset_v1alpha2_FailurePolicyRule(ref common.ReferenceCal
 							},
 						},
 					},
+					"maxRestarts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maxRestarts overrides the limit on the number of restarts of the ReplicatedJob of the failed Job when the RestartJobSet action of this rule is executed. It takes precedence over the replicatedJobMaxRestarts of the failure policy.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 				Required: []string{"name", "action"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/batch/v1.PodFailurePolicyOnExitCodesRequirement", "k8s.io/api/batch/v1.PodFailurePolicyOnPodConditionsPattern"},
+	}
+}
+
+func schema_jobset_api_jobset_v1alpha2_Framework(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Framework defines a distributed training framework preset.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name of the framework: PyTorch, JAX, TensorFlow or MPI.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "port used by the framework for the rendezvous of the pods. Defaults to 29500 for PyTorch, 1234 for JAX and 2222 for TensorFlow. It is not used by MPI.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_jobset_api_jobset_v1alpha2_JobRestartStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JobRestartStatus defines the number of times a child Job has been recreated.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name of the child Job.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"restarts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "restarts is the number of times the child Job has been recreated.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "restarts"},
+			},
+		},
 	}
 }
 
@@ -393,6 +585,19 @@ func schema_jobset_api_jobset_v1alpha2_JobSetSpec(ref common.ReferenceCallback)
 							Ref:         ref("sigs.k8s.io/jobset/api/jobset/v1alpha2.Coordinator"),
 						},
 					},
+					"injectTopologyEnv": {
+						SchemaProps: spec.SchemaProps{
+							Description: "injectTopologyEnv, when set to true, injects the topology of the JobSet as environment variables into every container and init container of the child Jobs: JOBSET_NAME, REPLICATED_JOB_NAME, JOB_INDEX, JOB_GLOBAL_INDEX, GLOBAL_REPLICAS, GROUP_NAME, GROUP_REPLICAS, GROUP_JOB_INDEX, RESTART_ATTEMPT and, if a coordinator is defined, COORDINATOR_ADDRESS. Environment variables already defined by a container are not overridden. It can be overridden per ReplicatedJob.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"framework": {
+						SchemaProps: spec.SchemaProps{
+							Description: "framework is the distributed training framework preset of the JobSet. When set, the rendezvous environment variables of the framework (e.g. PET_MASTER_ADDR, PET_NODE_RANK and PET_NNODES for PyTorch) are injected into the containers of the pods, derived from their indexes and their hostnames. Environment variables already defined by a container are not overridden. It requires spec.network.enableDNSHostnames and can be overridden per ReplicatedJob.",
+							Ref:         ref("sigs.k8s.io/jobset/api/jobset/v1alpha2.Framework"),
+						},
+					},
 					"managedBy": {
 						SchemaProps: spec.SchemaProps{
 							Description: "managedBy is used to indicate the controller or entity that manages a JobSet. The built-in JobSet controller reconciles JobSets which don't have this field at all or the field value is the reserved string `jobset.sigs.k8s.io/jobset-controller`, but skips reconciling JobSets with a custom value for this field.\n\nThe value must be a valid domain-prefixed path (e.g. acme.io/foo) - all characters before the first \"/\" must be a valid subdomain as defined by RFC 1123. All characters trailing the first \"/\" must be valid HTTP Path characters as defined by RFC 3986. The value cannot exceed 63 characters. The field is immutable.",
@@ -407,11 +612,24 @@ func schema_jobset_api_jobset_v1alpha2_JobSetSpec(ref common.ReferenceCallback)
 							Format:      "int32",
 						},
 					},
+					"activeDeadlineSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "activeDeadlineSeconds is the duration in seconds, relative to the first start of the JobSet, that the JobSet may be active before the system tries to terminate it. The deadline is not reset when the JobSet is restarted, and the time during which the JobSet is suspended does not count towards it. Once reached, the JobSet is failed with reason DeadlineExceeded and its child Jobs are deleted.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"scale": {
+						SchemaProps: spec.SchemaProps{
+							Description: "scale exposes the replicas of one ReplicatedJob through the scale subresource of the JobSet, so elastic workloads can be resized with `kubectl scale` or by autoscalers. Updates of scale.replicas are applied to the replicas of the target ReplicatedJob.",
+							Ref:         ref("sigs.k8s.io/jobset/api/jobset/v1alpha2.ReplicatedJobScale"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/jobset/api/jobset/v1alpha2.Coordinator", "sigs.k8s.io/jobset/api/jobset/v1alpha2.FailurePolicy", "sigs.k8s.io/jobset/api/jobset/v1alpha2.Network", "sigs.k8s.io/jobset/api/jobset/v1alpha2.ReplicatedJob", "sigs.k8s.io/jobset/api/jobset/v1alpha2.StartupPolicy", "sigs.k8s.io/jobset/api/jobset/v1alpha2.SuccessPolicy"},
+			"sigs.k8s.io/jobset/api/jobset/v1alpha2.Coordinator", "sigs.k8s.io/jobset/api/jobset/v1alpha2.FailurePolicy", "sigs.k8s.io/jobset/api/jobset/v1alpha2.Framework", "sigs.k8s.io/jobset/api/jobset/v1alpha2.Network", "sigs.k8s.io/jobset/api/jobset/v1alpha2.ReplicatedJob", "sigs.k8s.io/jobset/api/jobset/v1alpha2.ReplicatedJobScale", "sigs.k8s.io/jobset/api/jobset/v1alpha2.StartupPolicy", "sigs.k8s.io/jobset/api/jobset/v1alpha2.SuccessPolicy"},
 	}
 }
 
@@ -444,6 +662,13 @@ func schema_jobset_api_jobset_v1alpha2_JobSetStatus(ref common.ReferenceCallback
 							},
 						},
 					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "observedGeneration is the most recent generation of the JobSet observed by the controller.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 					"restarts": {
 						SchemaProps: spec.SchemaProps{
 							Description: "restarts tracks the number of times the JobSet has restarted (i.e. recreated in case of RecreateAll policy).",
@@ -488,83 +713,71 @@ func schema_jobset_api_jobset_v1alpha2_JobSetStatus(ref common.ReferenceCallback
 							},
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "sigs.k8s.io/jobset/api/jobset/v1alpha2.ReplicatedJobStatus"},
-	}
-}
-
-func schema_jobset_api_jobset_v1alpha2_Network(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"enableDNSHostnames": {
+					"pods": {
 						SchemaProps: spec.SchemaProps{
-							Description: "enableDNSHostnames allows pods to be reached via their hostnames. Pods will be reachable using the fully qualified pod hostname: <jobSet.name>-<spec.replicatedJob.name>-<job-index>-<pod-index>.<subdomain>",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "pods is the total number of pods of the current child Jobs of the JobSet, aggregated over all its ReplicatedJobs.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/jobset/api/jobset/v1alpha2.PodsStatus"),
 						},
 					},
-					"subdomain": {
+					"previousInPlaceRestartAttempt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "subdomain is an explicit choice for a network subdomain name When set, any replicated job in the set is added to this network. Defaults to <jobSet.name> if not set.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "previousInPlaceRestartAttempt is the previous in-place restart attempt of the JobSet. Healthy pods with an in-place restart attempt smaller than or equal to this value should be restarted in-place. This is written by the JobSet controller and read by the agent sidecars.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"publishNotReadyAddresses": {
+					"currentInPlaceRestartAttempt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "publishNotReadyAddresses indicates if DNS records of pods should be published before the pods are ready. Defaults to True.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "currentInPlaceRestartAttempt is the current in-place restart attempt of the JobSet. Pods with an in-place restart attempt equal to this value should lift their barrier to allow the worker containers to start running. This is written by the JobSet controller and read by the agent sidecars.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_jobset_api_jobset_v1alpha2_ReplicatedJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"nextRestartTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "name is the name of the entry and will be used as a suffix for the Job name.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "nextRestartTime is the time after which the JobSet will be restarted, when restarts are delayed by the restartBackoff of the failure policy.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"groupName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "groupName defines the name of the group this ReplicatedJob belongs to. Defaults to \"default\"",
-							Type:        []string{"string"},
-							Format:      "",
+					"restartTimes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
 						},
-					},
-					"template": {
 						SchemaProps: spec.SchemaProps{
-							Description: "template defines the template of the Job that will be created.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/batch/v1.JobTemplateSpec"),
+							Description: "restartTimes are the times of the restarts of the JobSet counting towards maxRestarts, kept when restartsWindowSeconds or resetRestartsAfterHealthySeconds is set in the failure policy. Restarts outside of the restart window are pruned.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+									},
+								},
+							},
 						},
 					},
-					"replicas": {
+					"restartHistory": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "replicas is the number of jobs that will be created from this ReplicatedJob's template. Jobs names will be in the format: <jobSet.name>-<spec.replicatedJob.name>-<job-index>",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "restartHistory records the most recent restarts of the JobSet triggered by its failure policy, oldest first, so that restarts can be investigated after their events have expired. At most 10 restarts are kept.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/jobset/api/jobset/v1alpha2.RestartHistoryEntry"),
+									},
+								},
+							},
 						},
 					},
-					"dependsOn": {
+					"jobRestarts": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-map-keys": []interface{}{
@@ -574,30 +787,486 @@ func schema_jobset_api_jobset_v1alpha2_ReplicatedJob(ref common.ReferenceCallbac
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "dependsOn is an optional list that specifies the preceding ReplicatedJobs upon which the current ReplicatedJob depends. If specified, the ReplicatedJob will be created only after the referenced ReplicatedJobs reach their desired state. The Order of ReplicatedJobs is defined by their enumeration in the slice. Note, that the first ReplicatedJob in the slice cannot use the DependsOn API. Currently, only a single item is supported in the DependsOn list. If JobSet is suspended the all active ReplicatedJobs will be suspended. When JobSet is resumed the Job sequence starts again. This API is mutually exclusive with the StartupPolicy API.",
+							Description: "jobRestarts tracks the number of times each child Job has been recreated when the RecreateFailedJobs or InPlaceRestart restart strategy is used.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("sigs.k8s.io/jobset/api/jobset/v1alpha2.DependsOn"),
+										Ref:     ref("sigs.k8s.io/jobset/api/jobset/v1alpha2.JobRestartStatus"),
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"name", "template"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/api/batch/v1.JobTemplateSpec", "sigs.k8s.io/jobset/api/jobset/v1alpha2.DependsOn"},
-	}
-}
-
-func schema_jobset_api_jobset_v1alpha2_ReplicatedJobStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "startTime is the time the JobSet was first started, i.e. first running while not suspended. It is not reset when the JobSet is restarted.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"currentAttemptStartTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "currentAttemptStartTime is the time the current attempt of the JobSet was started, i.e. its startTime for the first attempt, and the time of its last restart otherwise. Recreating only the failed Jobs with the RecreateFailedJobs or InPlaceRestart restart strategy does not start a new attempt.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "completionTime is the time the JobSet finished, i.e. was marked Completed or Failed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"suspendedDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "suspendedDuration is the cumulative time the JobSet has been suspended since its startTime, excluding the current suspension, if any.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"suspendedSince": {
+						SchemaProps: spec.SchemaProps{
+							Description: "suspendedSince is the time the JobSet was last suspended after its startTime. It is cleared when the JobSet is resumed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"activeDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "activeDuration is the time the JobSet was active from its startTime to its completionTime, excluding the time during which it was suspended. It is set when the JobSet finishes.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"scale": {
+						SchemaProps: spec.SchemaProps{
+							Description: "scale is the observed state of the ReplicatedJob scaled through the scale subresource.",
+							Ref:         ref("sigs.k8s.io/jobset/api/jobset/v1alpha2.ReplicatedJobScaleStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "sigs.k8s.io/jobset/api/jobset/v1alpha2.JobRestartStatus", "sigs.k8s.io/jobset/api/jobset/v1alpha2.PodsStatus", "sigs.k8s.io/jobset/api/jobset/v1alpha2.ReplicatedJobScaleStatus", "sigs.k8s.io/jobset/api/jobset/v1alpha2.ReplicatedJobStatus", "sigs.k8s.io/jobset/api/jobset/v1alpha2.RestartHistoryEntry"},
+	}
+}
+
+func schema_jobset_api_jobset_v1alpha2_Network(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enableDNSHostnames": {
+						SchemaProps: spec.SchemaProps{
+							Description: "enableDNSHostnames allows pods to be reached via their hostnames. Pods will be reachable using the fully qualified pod hostname: <jobSet.name>-<spec.replicatedJob.name>-<job-index>-<pod-index>.<subdomain>",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"subdomain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "subdomain is an explicit choice for a network subdomain name When set, any replicated job in the set without a network override is added to this network. Defaults to <jobSet.name> if not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"publishNotReadyAddresses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "publishNotReadyAddresses indicates if DNS records of pods should be published before the pods are ready. Defaults to True.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"group": {
+						SchemaProps: spec.SchemaProps{
+							Description: "group is the name of a network group shared by multiple JobSets in the same namespace. The JobSets of a network group share the headless service named after their subdomain, which selects the pods of all of them, so that their pods can resolve each other. The shared service is owned by every JobSet of the group and is only garbage collected once all of them are deleted. All the JobSets of a network group must set the same subdomain and publishNotReadyAddresses.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ports": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ports are the named ports exposed by the headless services of the JobSet, for which DNS SRV records are published. All the JobSets of a network group must set the same ports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/jobset/api/jobset/v1alpha2.NetworkPort"),
+									},
+								},
+							},
+						},
+					},
+					"isolation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "isolation, when set, creates a NetworkPolicy named <jobSetName>-isolation isolating the pods of the JobSet: they only accept traffic from the pods of the same JobSet, from the pods of the JobSets of its network group if set, and from the peers and on the ports listed here. The child Jobs are not created while a NetworkPolicy with the same name not controlled by the JobSet exists, see the NetworkPolicyConflict condition. The NetworkPolicy is deleted along with the JobSet.",
+							Ref:         ref("sigs.k8s.io/jobset/api/jobset/v1alpha2.NetworkIsolation"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/jobset/api/jobset/v1alpha2.NetworkIsolation", "sigs.k8s.io/jobset/api/jobset/v1alpha2.NetworkPort"},
+	}
+}
+
+func schema_jobset_api_jobset_v1alpha2_NetworkIsolation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkIsolation defines the exceptions to the isolation of the pods of a JobSet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Description: "from are additional peers allowed to send traffic to the pods of the JobSet on any port.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/networking/v1.NetworkPolicyPeer"),
+									},
+								},
+							},
+						},
+					},
+					"ports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ports are additional ports of the pods of the JobSet accepting traffic from any peer.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/networking/v1.NetworkPolicyPort"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/networking/v1.NetworkPolicyPeer", "k8s.io/api/networking/v1.NetworkPolicyPort"},
+	}
+}
+
+func schema_jobset_api_jobset_v1alpha2_NetworkPort(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPort defines a named port exposed by a service of the JobSet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name of the port. It must be an IANA_SVC_NAME and unique within the ports.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "port is the port number exposed by the service.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"targetPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "targetPort is the number or name of the port on the pods. Defaults to port.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"protocol": {
+						SchemaProps: spec.SchemaProps{
+							Description: "protocol is the IP protocol of the port. Defaults to TCP.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "port"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_jobset_api_jobset_v1alpha2_PodsStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PodsStatus is the number of pods of a set of child Jobs, aggregated from the status of the Jobs.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"active": {
+						SchemaProps: spec.SchemaProps{
+							Description: "active is the number of pending or running pods.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"notReady": {
+						SchemaProps: spec.SchemaProps{
+							Description: "notReady is the number of active pods which are not ready, i.e. active minus ready. The status of the child Jobs does not distinguish pending pods from running pods which have not passed their readiness checks yet, so both are counted here.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"ready": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ready is the number of active pods which are ready.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"succeeded": {
+						SchemaProps: spec.SchemaProps{
+							Description: "succeeded is the number of succeeded pods.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"failed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "failed is the number of failed pods.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_jobset_api_jobset_v1alpha2_ReplicatedJob(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the name of the entry and will be used as a suffix for the Job name.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"groupName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "groupName defines the name of the group this ReplicatedJob belongs to. Defaults to \"default\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "template defines the template of the Job that will be created.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/batch/v1.JobTemplateSpec"),
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "replicas is the number of jobs that will be created from this ReplicatedJob's template. Jobs names will be in the format: <jobSet.name>-<spec.replicatedJob.name>-<job-index> The replicas of the last ReplicatedJob can be updated on a running JobSet. On scale up, the missing Jobs are created. On scale down, the Jobs with the highest indices are deleted. The global and group indices and replicas of new Jobs are computed from the current spec, while existing Jobs keep the values they were created with until they are recreated. Since global and group indices are assigned in the order of the ReplicatedJobs, the replicas of the other ReplicatedJobs cannot be updated, as it would shift the indices of the Jobs of the ReplicatedJobs listed after them.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"dependsOn": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "dependsOn is an optional list that specifies the preceding ReplicatedJobs upon which the current ReplicatedJob depends. If specified, the ReplicatedJob will be created only after the referenced ReplicatedJobs reach their desired state. A ReplicatedJob can depend on multiple ReplicatedJobs, regardless of their enumeration in the slice, as long as the dependencies between ReplicatedJobs form a directed acyclic graph. If JobSet is suspended the all active ReplicatedJobs will be suspended. When JobSet is resumed the Job sequence starts again. This API is mutually exclusive with the StartupPolicy API.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/jobset/api/jobset/v1alpha2.DependsOn"),
+									},
+								},
+							},
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "suspend suspends the child Jobs of this ReplicatedJob when set to true, while the child Jobs of the other ReplicatedJobs keep running. The child Jobs are resumed when it is set back to false, unless the JobSet itself is suspended. A suspended ReplicatedJob does not block the startup of the ReplicatedJobs following it with the InOrder startup policy, but it blocks the ReplicatedJobs depending on it.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"network": {
+						SchemaProps: spec.SchemaProps{
+							Description: "network overrides the network configuration of the JobSet for the pods of this ReplicatedJob. It only has an effect when spec.network.enableDNSHostnames is true.",
+							Ref:         ref("sigs.k8s.io/jobset/api/jobset/v1alpha2.ReplicatedJobNetwork"),
+						},
+					},
+					"injectTopologyEnv": {
+						SchemaProps: spec.SchemaProps{
+							Description: "injectTopologyEnv overrides spec.injectTopologyEnv for the child Jobs of this ReplicatedJob.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"framework": {
+						SchemaProps: spec.SchemaProps{
+							Description: "framework overrides spec.framework for the pods of this ReplicatedJob. The pods of all the ReplicatedJobs using the same framework form a single distributed world, ranked in the order the ReplicatedJobs are listed.",
+							Ref:         ref("sigs.k8s.io/jobset/api/jobset/v1alpha2.Framework"),
+						},
+					},
+				},
+				Required: []string{"name", "template"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/batch/v1.JobTemplateSpec", "sigs.k8s.io/jobset/api/jobset/v1alpha2.DependsOn", "sigs.k8s.io/jobset/api/jobset/v1alpha2.Framework", "sigs.k8s.io/jobset/api/jobset/v1alpha2.ReplicatedJobNetwork"},
+	}
+}
+
+func schema_jobset_api_jobset_v1alpha2_ReplicatedJobMaxRestarts(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReplicatedJobMaxRestarts defines the limit on the number of restarts triggered by a ReplicatedJob.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name of the ReplicatedJob.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxRestarts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maxRestarts defines the limit on the number of JobSet restarts triggered by a failed child Job of the ReplicatedJob.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "maxRestarts"},
+			},
+		},
+	}
+}
+
+func schema_jobset_api_jobset_v1alpha2_ReplicatedJobNetwork(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReplicatedJobNetwork defines the network configuration of a single ReplicatedJob.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"subdomain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "subdomain is the network subdomain of the pods of this ReplicatedJob. A dedicated headless service with this name, selecting only the pods of this ReplicatedJob, is created. Pods will be reachable using the fully qualified pod hostname: <jobSet.name>-<spec.replicatedJob.name>-<job-index>-<pod-index>.<subdomain> It must be unique among the ReplicatedJobs and differ from spec.network.subdomain.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"publishNotReadyAddresses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "publishNotReadyAddresses indicates if DNS records of the pods of this ReplicatedJob should be published before the pods are ready. Defaults to spec.network.publishNotReadyAddresses.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"subdomain"},
+			},
+		},
+	}
+}
+
+func schema_jobset_api_jobset_v1alpha2_ReplicatedJobScale(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReplicatedJobScale defines the ReplicatedJob scaled through the scale subresource of the JobSet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicatedJob": {
+						SchemaProps: spec.SchemaProps{
+							Description: "replicatedJob is the name of the ReplicatedJob scaled through the scale subresource. It must be the last ReplicatedJob of the JobSet. The field is immutable.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "replicas is the desired number of replicas of the target ReplicatedJob. Defaults to the replicas of the target ReplicatedJob.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"replicatedJob"},
+			},
+		},
+	}
+}
+
+func schema_jobset_api_jobset_v1alpha2_ReplicatedJobScaleStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReplicatedJobScaleStatus defines the observed state of the ReplicatedJob scaled through the scale subresource of the JobSet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "replicas is the number of child Jobs of the current run of the target ReplicatedJob.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "selector is the label selector of the pods of the target ReplicatedJob, in the serialized form expected by the scale subresource.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"replicas"},
+			},
+		},
+	}
+}
+
+func schema_jobset_api_jobset_v1alpha2_ReplicatedJobStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Description: "ReplicatedJobStatus defines the observed ReplicatedJobs Readiness.",
 				Type:        []string{"object"},
@@ -650,10 +1319,160 @@ func schema_jobset_api_jobset_v1alpha2_ReplicatedJobStatus(ref common.ReferenceC
 							Format:      "int32",
 						},
 					},
+					"pods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "pods is the number of pods of the child Jobs of the ReplicatedJob, aggregated from the status of the child Jobs.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/jobset/api/jobset/v1alpha2.PodsStatus"),
+						},
+					},
+					"suspend": {
+						SchemaProps: spec.SchemaProps{
+							Description: "suspend is true when the ReplicatedJob is suspended by its suspend field.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"restarts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "restarts is the number of JobSet restarts triggered by a failed child Job of this ReplicatedJob.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"restartsCountTowardsMax": {
+						SchemaProps: spec.SchemaProps{
+							Description: "restartsCountTowardsMax is the number of JobSet restarts triggered by a failed child Job of this ReplicatedJob that count towards its own restart limit, i.e. the maxRestarts of the matching failure policy rule or its replicatedJobMaxRestarts. Restarts counting towards the maxRestarts of the JobSet are not included.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"restartTimes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "restartTimes are the times of the restarts counting towards restartsCountTowardsMax, kept when restartsWindowSeconds or resetRestartsAfterHealthySeconds is set in the failure policy. Restarts outside of the restart window are pruned.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"name", "ready", "succeeded", "failed", "active", "suspended"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "sigs.k8s.io/jobset/api/jobset/v1alpha2.PodsStatus"},
+	}
+}
+
+func schema_jobset_api_jobset_v1alpha2_RestartBackoff(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RestartBackoff defines the delay before a JobSet restart, computed as initialDelaySeconds * multiplier^restarts and capped at maxDelaySeconds, where restarts is the number of restarts since the JobSet was last Ready, as recorded in status.restartHistory.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"initialDelaySeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "initialDelaySeconds is the delay before the first restart of the JobSet.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"multiplier": {
+						SchemaProps: spec.SchemaProps{
+							Description: "multiplier is the factor by which the delay is multiplied for each restart. Defaults to 2.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxDelaySeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maxDelaySeconds is the maximum delay between restarts of the JobSet. Defaults to 600 (10 minutes).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"initialDelaySeconds"},
+			},
+		},
+	}
+}
+
+func schema_jobset_api_jobset_v1alpha2_RestartHistoryEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RestartHistoryEntry describes a restart of the JobSet triggered by its failure policy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"attempt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "attempt is the restart attempt of the JobSet started by this restart, i.e. the value of status.restarts after the restart. When the RecreateFailedJobs or InPlaceRestart restart strategy is used, this is instead the restart attempt of the recreated job, i.e. its value in status.jobRestarts after the restart.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "time is the time the restart was triggered.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"failedJob": {
+						SchemaProps: spec.SchemaProps{
+							Description: "failedJob is the name of the failed child Job which triggered the restart.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"failurePolicyRule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "failurePolicyRule is the name of the failure policy rule matching the failed child Job. It is empty if no rule matched and the default action was applied.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "action is the failure policy action which triggered the restart.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "reason is the reason of the failure condition of the failed child Job.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "message is the message of the failure condition of the failed child Job.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"attempt", "time", "action"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -686,12 +1505,19 @@ func schema_jobset_api_jobset_v1alpha2_SuccessPolicy(ref common.ReferenceCallbac
 				Properties: map[string]spec.Schema{
 					"operator": {
 						SchemaProps: spec.SchemaProps{
-							Description: "operator determines either All or Any of the selected jobs should succeed to consider the JobSet successful",
+							Description: "operator determines either All, Any or AtLeast minSucceeded of the selected jobs should succeed to consider the JobSet successful",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"minSucceeded": {
+						SchemaProps: spec.SchemaProps{
+							Description: "minSucceeded is the number of the selected jobs which should succeed to consider the JobSet successful when the AtLeast operator is used. It must be set if and only if the operator is AtLeast, and must not exceed the number of replicas of the target replicated jobs. Once the JobSet is successful, its remaining active jobs are deleted.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"targetReplicatedJobs": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -18,13 +18,21 @@ limitations under the License.
 package v1alpha2
 
 import (
+	batchv1 "k8s.io/api/batch/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Coordinator) DeepCopyInto(out *Coordinator) {
 	*out = *in
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(CoordinatorService)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Coordinator.
@@ -37,6 +45,28 @@ func (in *Coordinator) DeepCopy() *Coordinator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoordinatorService) DeepCopyInto(out *CoordinatorService) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]NetworkPort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoordinatorService.
+func (in *CoordinatorService) DeepCopy() *CoordinatorService {
+	if in == nil {
+		return nil
+	}
+	out := new(CoordinatorService)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DependsOn) DeepCopyInto(out *DependsOn) {
 	*out = *in
@@ -55,6 +85,11 @@ func (in *DependsOn) DeepCopy() *DependsOn {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FailurePolicy) DeepCopyInto(out *FailurePolicy) {
 	*out = *in
+	if in.ReplicatedJobMaxRestarts != nil {
+		in, out := &in.ReplicatedJobMaxRestarts, &out.ReplicatedJobMaxRestarts
+		*out = make([]ReplicatedJobMaxRestarts, len(*in))
+		copy(*out, *in)
+	}
 	if in.Rules != nil {
 		in, out := &in.Rules, &out.Rules
 		*out = make([]FailurePolicyRule, len(*in))
@@ -62,6 +97,21 @@ func (in *FailurePolicy) DeepCopyInto(out *FailurePolicy) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RestartsWindowSeconds != nil {
+		in, out := &in.RestartsWindowSeconds, &out.RestartsWindowSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ResetRestartsAfterHealthySeconds != nil {
+		in, out := &in.ResetRestartsAfterHealthySeconds, &out.ResetRestartsAfterHealthySeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RestartBackoff != nil {
+		in, out := &in.RestartBackoff, &out.RestartBackoff
+		*out = new(RestartBackoff)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailurePolicy.
@@ -87,11 +137,26 @@ func (in *FailurePolicyRule) DeepCopyInto(out *FailurePolicyRule) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.OnPodExitCodes != nil {
+		in, out := &in.OnPodExitCodes, &out.OnPodExitCodes
+		*out = new(batchv1.PodFailurePolicyOnExitCodesRequirement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OnPodConditions != nil {
+		in, out := &in.OnPodConditions, &out.OnPodConditions
+		*out = make([]batchv1.PodFailurePolicyOnPodConditionsPattern, len(*in))
+		copy(*out, *in)
+	}
 	if in.TargetReplicatedJobs != nil {
 		in, out := &in.TargetReplicatedJobs, &out.TargetReplicatedJobs
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MaxRestarts != nil {
+		in, out := &in.MaxRestarts, &out.MaxRestarts
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailurePolicyRule.
@@ -104,6 +169,41 @@ func (in *FailurePolicyRule) DeepCopy() *FailurePolicyRule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Framework) DeepCopyInto(out *Framework) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Framework.
+func (in *Framework) DeepCopy() *Framework {
+	if in == nil {
+		return nil
+	}
+	out := new(Framework)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobRestartStatus) DeepCopyInto(out *JobRestartStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobRestartStatus.
+func (in *JobRestartStatus) DeepCopy() *JobRestartStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JobRestartStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JobSet) DeepCopyInto(out *JobSet) {
 	*out = *in
@@ -201,8 +301,18 @@ func (in *JobSetSpec) DeepCopyInto(out *JobSetSpec) {
 	if in.Coordinator != nil {
 		in, out := &in.Coordinator, &out.Coordinator
 		*out = new(Coordinator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InjectTopologyEnv != nil {
+		in, out := &in.InjectTopologyEnv, &out.InjectTopologyEnv
+		*out = new(bool)
 		**out = **in
 	}
+	if in.Framework != nil {
+		in, out := &in.Framework, &out.Framework
+		*out = new(Framework)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ManagedBy != nil {
 		in, out := &in.ManagedBy, &out.ManagedBy
 		*out = new(string)
@@ -213,6 +323,16 @@ func (in *JobSetSpec) DeepCopyInto(out *JobSetSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Scale != nil {
+		in, out := &in.Scale, &out.Scale
+		*out = new(ReplicatedJobScale)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobSetSpec.
@@ -238,8 +358,75 @@ func (in *JobSetStatus) DeepCopyInto(out *JobSetStatus) {
 	if in.ReplicatedJobsStatus != nil {
 		in, out := &in.ReplicatedJobsStatus, &out.ReplicatedJobsStatus
 		*out = make([]ReplicatedJobStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.Pods = in.Pods
+	if in.PreviousInPlaceRestartAttempt != nil {
+		in, out := &in.PreviousInPlaceRestartAttempt, &out.PreviousInPlaceRestartAttempt
+		*out = new(int32)
+		**out = **in
+	}
+	if in.CurrentInPlaceRestartAttempt != nil {
+		in, out := &in.CurrentInPlaceRestartAttempt, &out.CurrentInPlaceRestartAttempt
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NextRestartTime != nil {
+		in, out := &in.NextRestartTime, &out.NextRestartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RestartTimes != nil {
+		in, out := &in.RestartTimes, &out.RestartTimes
+		*out = make([]v1.Time, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RestartHistory != nil {
+		in, out := &in.RestartHistory, &out.RestartHistory
+		*out = make([]RestartHistoryEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.JobRestarts != nil {
+		in, out := &in.JobRestarts, &out.JobRestarts
+		*out = make([]JobRestartStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CurrentAttemptStartTime != nil {
+		in, out := &in.CurrentAttemptStartTime, &out.CurrentAttemptStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.SuspendedDuration != nil {
+		in, out := &in.SuspendedDuration, &out.SuspendedDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.SuspendedSince != nil {
+		in, out := &in.SuspendedSince, &out.SuspendedSince
+		*out = (*in).DeepCopy()
+	}
+	if in.ActiveDuration != nil {
+		in, out := &in.ActiveDuration, &out.ActiveDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Scale != nil {
+		in, out := &in.Scale, &out.Scale
+		*out = new(ReplicatedJobScaleStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobSetStatus.
@@ -265,6 +452,18 @@ func (in *Network) DeepCopyInto(out *Network) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]NetworkPort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Isolation != nil {
+		in, out := &in.Isolation, &out.Isolation
+		*out = new(NetworkIsolation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Network.
@@ -277,6 +476,70 @@ func (in *Network) DeepCopy() *Network {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkIsolation) DeepCopyInto(out *NetworkIsolation) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]networkingv1.NetworkPolicyPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]networkingv1.NetworkPolicyPort, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkIsolation.
+func (in *NetworkIsolation) DeepCopy() *NetworkIsolation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkIsolation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPort) DeepCopyInto(out *NetworkPort) {
+	*out = *in
+	if in.TargetPort != nil {
+		in, out := &in.TargetPort, &out.TargetPort
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPort.
+func (in *NetworkPort) DeepCopy() *NetworkPort {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodsStatus) DeepCopyInto(out *PodsStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodsStatus.
+func (in *PodsStatus) DeepCopy() *PodsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PodsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReplicatedJob) DeepCopyInto(out *ReplicatedJob) {
 	*out = *in
@@ -286,6 +549,26 @@ func (in *ReplicatedJob) DeepCopyInto(out *ReplicatedJob) {
 		*out = make([]DependsOn, len(*in))
 		copy(*out, *in)
 	}
+	if in.Suspend != nil {
+		in, out := &in.Suspend, &out.Suspend
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(ReplicatedJobNetwork)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InjectTopologyEnv != nil {
+		in, out := &in.InjectTopologyEnv, &out.InjectTopologyEnv
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Framework != nil {
+		in, out := &in.Framework, &out.Framework
+		*out = new(Framework)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicatedJob.
@@ -298,9 +581,87 @@ func (in *ReplicatedJob) DeepCopy() *ReplicatedJob {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicatedJobMaxRestarts) DeepCopyInto(out *ReplicatedJobMaxRestarts) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicatedJobMaxRestarts.
+func (in *ReplicatedJobMaxRestarts) DeepCopy() *ReplicatedJobMaxRestarts {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicatedJobMaxRestarts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicatedJobNetwork) DeepCopyInto(out *ReplicatedJobNetwork) {
+	*out = *in
+	if in.PublishNotReadyAddresses != nil {
+		in, out := &in.PublishNotReadyAddresses, &out.PublishNotReadyAddresses
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicatedJobNetwork.
+func (in *ReplicatedJobNetwork) DeepCopy() *ReplicatedJobNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicatedJobNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicatedJobScale) DeepCopyInto(out *ReplicatedJobScale) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicatedJobScale.
+func (in *ReplicatedJobScale) DeepCopy() *ReplicatedJobScale {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicatedJobScale)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicatedJobScaleStatus) DeepCopyInto(out *ReplicatedJobScaleStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicatedJobScaleStatus.
+func (in *ReplicatedJobScaleStatus) DeepCopy() *ReplicatedJobScaleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicatedJobScaleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReplicatedJobStatus) DeepCopyInto(out *ReplicatedJobStatus) {
 	*out = *in
+	out.Pods = in.Pods
+	if in.RestartTimes != nil {
+		in, out := &in.RestartTimes, &out.RestartTimes
+		*out = make([]v1.Time, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicatedJobStatus.
@@ -313,6 +674,37 @@ func (in *ReplicatedJobStatus) DeepCopy() *ReplicatedJobStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestartBackoff) DeepCopyInto(out *RestartBackoff) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestartBackoff.
+func (in *RestartBackoff) DeepCopy() *RestartBackoff {
+	if in == nil {
+		return nil
+	}
+	out := new(RestartBackoff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestartHistoryEntry) DeepCopyInto(out *RestartHistoryEntry) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestartHistoryEntry.
+func (in *RestartHistoryEntry) DeepCopy() *RestartHistoryEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(RestartHistoryEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StartupPolicy) DeepCopyInto(out *StartupPolicy) {
 	*out = *in
@@ -331,6 +723,11 @@ func (in *StartupPolicy) DeepCopy() *StartupPolicy {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SuccessPolicy) DeepCopyInto(out *SuccessPolicy) {
 	*out = *in
+	if in.MinSucceeded != nil {
+		in, out := &in.MinSucceeded, &out.MinSucceeded
+		*out = new(int32)
+		**out = **in
+	}
 	if in.TargetReplicatedJobs != nil {
 		in, out := &in.TargetReplicatedJobs, &out.TargetReplicatedJobs
 		*out = make([]string, len(*in))
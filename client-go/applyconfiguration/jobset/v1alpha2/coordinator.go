@@ -17,9 +17,10 @@ package v1alpha2
 // CoordinatorApplyConfiguration represents a declarative configuration of the Coordinator type for use
 // with apply.
 type CoordinatorApplyConfiguration struct {
-	ReplicatedJob *string `json:"replicatedJob,omitempty"`
-	JobIndex      *int    `json:"jobIndex,omitempty"`
-	PodIndex      *int    `json:"podIndex,omitempty"`
+	ReplicatedJob *string                               `json:"replicatedJob,omitempty"`
+	JobIndex      *int                                  `json:"jobIndex,omitempty"`
+	PodIndex      *int                                  `json:"podIndex,omitempty"`
+	Service       *CoordinatorServiceApplyConfiguration `json:"service,omitempty"`
 }
 
 // CoordinatorApplyConfiguration constructs a declarative configuration of the Coordinator type for use with
@@ -51,3 +52,11 @@ func (b *CoordinatorApplyConfiguration) WithPodIndex(value int) *CoordinatorAppl
 	b.PodIndex = &value
 	return b
 }
+
+// WithService sets the Service field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Service field is set to the value of the last call.
+func (b *CoordinatorApplyConfiguration) WithService(value *CoordinatorServiceApplyConfiguration) *CoordinatorApplyConfiguration {
+	b.Service = value
+	return b
+}
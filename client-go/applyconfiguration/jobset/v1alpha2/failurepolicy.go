@@ -21,9 +21,13 @@ import (
 // FailurePolicyApplyConfiguration represents a declarative configuration of the FailurePolicy type for use
 // with apply.
 type FailurePolicyApplyConfiguration struct {
-	MaxRestarts     *int32                                `json:"maxRestarts,omitempty"`
-	RestartStrategy *jobsetv1alpha2.JobSetRestartStrategy `json:"restartStrategy,omitempty"`
-	Rules           []FailurePolicyRuleApplyConfiguration `json:"rules,omitempty"`
+	MaxRestarts                      *int32                                       `json:"maxRestarts,omitempty"`
+	ReplicatedJobMaxRestarts         []ReplicatedJobMaxRestartsApplyConfiguration `json:"replicatedJobMaxRestarts,omitempty"`
+	RestartStrategy                  *jobsetv1alpha2.JobSetRestartStrategy        `json:"restartStrategy,omitempty"`
+	Rules                            []FailurePolicyRuleApplyConfiguration        `json:"rules,omitempty"`
+	RestartsWindowSeconds            *int32                                       `json:"restartsWindowSeconds,omitempty"`
+	ResetRestartsAfterHealthySeconds *int32                                       `json:"resetRestartsAfterHealthySeconds,omitempty"`
+	RestartBackoff                   *RestartBackoffApplyConfiguration            `json:"restartBackoff,omitempty"`
 }
 
 // FailurePolicyApplyConfiguration constructs a declarative configuration of the FailurePolicy type for use with
@@ -40,6 +44,19 @@ func (b *FailurePolicyApplyConfiguration) WithMaxRestarts(value int32) *FailureP
 	return b
 }
 
+// WithReplicatedJobMaxRestarts adds the given value to the ReplicatedJobMaxRestarts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the ReplicatedJobMaxRestarts field.
+func (b *FailurePolicyApplyConfiguration) WithReplicatedJobMaxRestarts(values ...*ReplicatedJobMaxRestartsApplyConfiguration) *FailurePolicyApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithReplicatedJobMaxRestarts")
+		}
+		b.ReplicatedJobMaxRestarts = append(b.ReplicatedJobMaxRestarts, *values[i])
+	}
+	return b
+}
+
 // WithRestartStrategy sets the RestartStrategy field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the RestartStrategy field is set to the value of the last call.
@@ -60,3 +77,27 @@ func (b *FailurePolicyApplyConfiguration) WithRules(values ...*FailurePolicyRule
 	}
 	return b
 }
+
+// WithRestartsWindowSeconds sets the RestartsWindowSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RestartsWindowSeconds field is set to the value of the last call.
+func (b *FailurePolicyApplyConfiguration) WithRestartsWindowSeconds(value int32) *FailurePolicyApplyConfiguration {
+	b.RestartsWindowSeconds = &value
+	return b
+}
+
+// WithResetRestartsAfterHealthySeconds sets the ResetRestartsAfterHealthySeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResetRestartsAfterHealthySeconds field is set to the value of the last call.
+func (b *FailurePolicyApplyConfiguration) WithResetRestartsAfterHealthySeconds(value int32) *FailurePolicyApplyConfiguration {
+	b.ResetRestartsAfterHealthySeconds = &value
+	return b
+}
+
+// WithRestartBackoff sets the RestartBackoff field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RestartBackoff field is set to the value of the last call.
+func (b *FailurePolicyApplyConfiguration) WithRestartBackoff(value *RestartBackoffApplyConfiguration) *FailurePolicyApplyConfiguration {
+	b.RestartBackoff = value
+	return b
+}
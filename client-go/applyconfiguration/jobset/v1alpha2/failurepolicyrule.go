@@ -15,17 +15,21 @@ limitations under the License.
 package v1alpha2
 
 import (
+	v1 "k8s.io/client-go/applyconfigurations/batch/v1"
 	jobsetv1alpha2 "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 )
 
 // FailurePolicyRuleApplyConfiguration represents a declarative configuration of the FailurePolicyRule type for use
 // with apply.
 type FailurePolicyRuleApplyConfiguration struct {
-	Name                        *string                             `json:"name,omitempty"`
-	Action                      *jobsetv1alpha2.FailurePolicyAction `json:"action,omitempty"`
-	OnJobFailureReasons         []string                            `json:"onJobFailureReasons,omitempty"`
-	OnJobFailureMessagePatterns []string                            `json:"onJobFailureMessagePatterns,omitempty"`
-	TargetReplicatedJobs        []string                            `json:"targetReplicatedJobs,omitempty"`
+	Name                        *string                                                       `json:"name,omitempty"`
+	Action                      *jobsetv1alpha2.FailurePolicyAction                           `json:"action,omitempty"`
+	OnJobFailureReasons         []string                                                      `json:"onJobFailureReasons,omitempty"`
+	OnJobFailureMessagePatterns []string                                                      `json:"onJobFailureMessagePatterns,omitempty"`
+	OnPodExitCodes              *v1.PodFailurePolicyOnExitCodesRequirementApplyConfiguration  `json:"onPodExitCodes,omitempty"`
+	OnPodConditions             []v1.PodFailurePolicyOnPodConditionsPatternApplyConfiguration `json:"onPodConditions,omitempty"`
+	TargetReplicatedJobs        []string                                                      `json:"targetReplicatedJobs,omitempty"`
+	MaxRestarts                 *int32                                                        `json:"maxRestarts,omitempty"`
 }
 
 // FailurePolicyRuleApplyConfiguration constructs a declarative configuration of the FailurePolicyRule type for use with
@@ -70,6 +74,27 @@ func (b *FailurePolicyRuleApplyConfiguration) WithOnJobFailureMessagePatterns(va
 	return b
 }
 
+// WithOnPodExitCodes sets the OnPodExitCodes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OnPodExitCodes field is set to the value of the last call.
+func (b *FailurePolicyRuleApplyConfiguration) WithOnPodExitCodes(value *v1.PodFailurePolicyOnExitCodesRequirementApplyConfiguration) *FailurePolicyRuleApplyConfiguration {
+	b.OnPodExitCodes = value
+	return b
+}
+
+// WithOnPodConditions adds the given value to the OnPodConditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the OnPodConditions field.
+func (b *FailurePolicyRuleApplyConfiguration) WithOnPodConditions(values ...*v1.PodFailurePolicyOnPodConditionsPatternApplyConfiguration) *FailurePolicyRuleApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithOnPodConditions")
+		}
+		b.OnPodConditions = append(b.OnPodConditions, *values[i])
+	}
+	return b
+}
+
 // WithTargetReplicatedJobs adds the given value to the TargetReplicatedJobs field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the TargetReplicatedJobs field.
@@ -79,3 +104,11 @@ func (b *FailurePolicyRuleApplyConfiguration) WithTargetReplicatedJobs(values ..
 	}
 	return b
 }
+
+// WithMaxRestarts sets the MaxRestarts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxRestarts field is set to the value of the last call.
+func (b *FailurePolicyRuleApplyConfiguration) WithMaxRestarts(value int32) *FailurePolicyRuleApplyConfiguration {
+	b.MaxRestarts = &value
+	return b
+}
@@ -17,15 +17,19 @@ package v1alpha2
 // JobSetSpecApplyConfiguration represents a declarative configuration of the JobSetSpec type for use
 // with apply.
 type JobSetSpecApplyConfiguration struct {
-	ReplicatedJobs          []ReplicatedJobApplyConfiguration `json:"replicatedJobs,omitempty"`
-	Network                 *NetworkApplyConfiguration        `json:"network,omitempty"`
-	SuccessPolicy           *SuccessPolicyApplyConfiguration  `json:"successPolicy,omitempty"`
-	FailurePolicy           *FailurePolicyApplyConfiguration  `json:"failurePolicy,omitempty"`
-	StartupPolicy           *StartupPolicyApplyConfiguration  `json:"startupPolicy,omitempty"`
-	Suspend                 *bool                             `json:"suspend,omitempty"`
-	Coordinator             *CoordinatorApplyConfiguration    `json:"coordinator,omitempty"`
-	ManagedBy               *string                           `json:"managedBy,omitempty"`
-	TTLSecondsAfterFinished *int32                            `json:"ttlSecondsAfterFinished,omitempty"`
+	ReplicatedJobs          []ReplicatedJobApplyConfiguration     `json:"replicatedJobs,omitempty"`
+	Network                 *NetworkApplyConfiguration            `json:"network,omitempty"`
+	SuccessPolicy           *SuccessPolicyApplyConfiguration      `json:"successPolicy,omitempty"`
+	FailurePolicy           *FailurePolicyApplyConfiguration      `json:"failurePolicy,omitempty"`
+	StartupPolicy           *StartupPolicyApplyConfiguration      `json:"startupPolicy,omitempty"`
+	Suspend                 *bool                                 `json:"suspend,omitempty"`
+	Coordinator             *CoordinatorApplyConfiguration        `json:"coordinator,omitempty"`
+	InjectTopologyEnv       *bool                                 `json:"injectTopologyEnv,omitempty"`
+	Framework               *FrameworkApplyConfiguration          `json:"framework,omitempty"`
+	ManagedBy               *string                               `json:"managedBy,omitempty"`
+	TTLSecondsAfterFinished *int32                                `json:"ttlSecondsAfterFinished,omitempty"`
+	ActiveDeadlineSeconds   *int64                                `json:"activeDeadlineSeconds,omitempty"`
+	Scale                   *ReplicatedJobScaleApplyConfiguration `json:"scale,omitempty"`
 }
 
 // JobSetSpecApplyConfiguration constructs a declarative configuration of the JobSetSpec type for use with
@@ -95,6 +99,22 @@ func (b *JobSetSpecApplyConfiguration) WithCoordinator(value *CoordinatorApplyCo
 	return b
 }
 
+// WithInjectTopologyEnv sets the InjectTopologyEnv field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InjectTopologyEnv field is set to the value of the last call.
+func (b *JobSetSpecApplyConfiguration) WithInjectTopologyEnv(value bool) *JobSetSpecApplyConfiguration {
+	b.InjectTopologyEnv = &value
+	return b
+}
+
+// WithFramework sets the Framework field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Framework field is set to the value of the last call.
+func (b *JobSetSpecApplyConfiguration) WithFramework(value *FrameworkApplyConfiguration) *JobSetSpecApplyConfiguration {
+	b.Framework = value
+	return b
+}
+
 // WithManagedBy sets the ManagedBy field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ManagedBy field is set to the value of the last call.
@@ -110,3 +130,19 @@ func (b *JobSetSpecApplyConfiguration) WithTTLSecondsAfterFinished(value int32)
 	b.TTLSecondsAfterFinished = &value
 	return b
 }
+
+// WithActiveDeadlineSeconds sets the ActiveDeadlineSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ActiveDeadlineSeconds field is set to the value of the last call.
+func (b *JobSetSpecApplyConfiguration) WithActiveDeadlineSeconds(value int64) *JobSetSpecApplyConfiguration {
+	b.ActiveDeadlineSeconds = &value
+	return b
+}
+
+// WithScale sets the Scale field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Scale field is set to the value of the last call.
+func (b *JobSetSpecApplyConfiguration) WithScale(value *ReplicatedJobScaleApplyConfiguration) *JobSetSpecApplyConfiguration {
+	b.Scale = value
+	return b
+}
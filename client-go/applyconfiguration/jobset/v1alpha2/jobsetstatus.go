@@ -15,17 +15,33 @@ limitations under the License.
 package v1alpha2
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
 )
 
 // JobSetStatusApplyConfiguration represents a declarative configuration of the JobSetStatus type for use
 // with apply.
 type JobSetStatusApplyConfiguration struct {
-	Conditions              []v1.ConditionApplyConfiguration        `json:"conditions,omitempty"`
-	Restarts                *int32                                  `json:"restarts,omitempty"`
-	RestartsCountTowardsMax *int32                                  `json:"restartsCountTowardsMax,omitempty"`
-	TerminalState           *string                                 `json:"terminalState,omitempty"`
-	ReplicatedJobsStatus    []ReplicatedJobStatusApplyConfiguration `json:"replicatedJobsStatus,omitempty"`
+	Conditions                    []v1.ConditionApplyConfiguration            `json:"conditions,omitempty"`
+	ObservedGeneration            *int64                                      `json:"observedGeneration,omitempty"`
+	Restarts                      *int32                                      `json:"restarts,omitempty"`
+	RestartsCountTowardsMax       *int32                                      `json:"restartsCountTowardsMax,omitempty"`
+	TerminalState                 *string                                     `json:"terminalState,omitempty"`
+	ReplicatedJobsStatus          []ReplicatedJobStatusApplyConfiguration     `json:"replicatedJobsStatus,omitempty"`
+	Pods                          *PodsStatusApplyConfiguration               `json:"pods,omitempty"`
+	PreviousInPlaceRestartAttempt *int32                                      `json:"previousInPlaceRestartAttempt,omitempty"`
+	CurrentInPlaceRestartAttempt  *int32                                      `json:"currentInPlaceRestartAttempt,omitempty"`
+	NextRestartTime               *metav1.Time                                `json:"nextRestartTime,omitempty"`
+	RestartTimes                  []metav1.Time                               `json:"restartTimes,omitempty"`
+	RestartHistory                []RestartHistoryEntryApplyConfiguration     `json:"restartHistory,omitempty"`
+	JobRestarts                   []JobRestartStatusApplyConfiguration        `json:"jobRestarts,omitempty"`
+	StartTime                     *metav1.Time                                `json:"startTime,omitempty"`
+	CurrentAttemptStartTime       *metav1.Time                                `json:"currentAttemptStartTime,omitempty"`
+	CompletionTime                *metav1.Time                                `json:"completionTime,omitempty"`
+	SuspendedDuration             *metav1.Duration                            `json:"suspendedDuration,omitempty"`
+	SuspendedSince                *metav1.Time                                `json:"suspendedSince,omitempty"`
+	ActiveDuration                *metav1.Duration                            `json:"activeDuration,omitempty"`
+	Scale                         *ReplicatedJobScaleStatusApplyConfiguration `json:"scale,omitempty"`
 }
 
 // JobSetStatusApplyConfiguration constructs a declarative configuration of the JobSetStatus type for use with
@@ -47,6 +63,14 @@ func (b *JobSetStatusApplyConfiguration) WithConditions(values ...*v1.ConditionA
 	return b
 }
 
+// WithObservedGeneration sets the ObservedGeneration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ObservedGeneration field is set to the value of the last call.
+func (b *JobSetStatusApplyConfiguration) WithObservedGeneration(value int64) *JobSetStatusApplyConfiguration {
+	b.ObservedGeneration = &value
+	return b
+}
+
 // WithRestarts sets the Restarts field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the Restarts field is set to the value of the last call.
@@ -83,3 +107,127 @@ func (b *JobSetStatusApplyConfiguration) WithReplicatedJobsStatus(values ...*Rep
 	}
 	return b
 }
+
+// WithPods sets the Pods field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Pods field is set to the value of the last call.
+func (b *JobSetStatusApplyConfiguration) WithPods(value *PodsStatusApplyConfiguration) *JobSetStatusApplyConfiguration {
+	b.Pods = value
+	return b
+}
+
+// WithPreviousInPlaceRestartAttempt sets the PreviousInPlaceRestartAttempt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PreviousInPlaceRestartAttempt field is set to the value of the last call.
+func (b *JobSetStatusApplyConfiguration) WithPreviousInPlaceRestartAttempt(value int32) *JobSetStatusApplyConfiguration {
+	b.PreviousInPlaceRestartAttempt = &value
+	return b
+}
+
+// WithCurrentInPlaceRestartAttempt sets the CurrentInPlaceRestartAttempt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CurrentInPlaceRestartAttempt field is set to the value of the last call.
+func (b *JobSetStatusApplyConfiguration) WithCurrentInPlaceRestartAttempt(value int32) *JobSetStatusApplyConfiguration {
+	b.CurrentInPlaceRestartAttempt = &value
+	return b
+}
+
+// WithNextRestartTime sets the NextRestartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NextRestartTime field is set to the value of the last call.
+func (b *JobSetStatusApplyConfiguration) WithNextRestartTime(value metav1.Time) *JobSetStatusApplyConfiguration {
+	b.NextRestartTime = &value
+	return b
+}
+
+// WithRestartTimes adds the given value to the RestartTimes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RestartTimes field.
+func (b *JobSetStatusApplyConfiguration) WithRestartTimes(values ...metav1.Time) *JobSetStatusApplyConfiguration {
+	for i := range values {
+		b.RestartTimes = append(b.RestartTimes, values[i])
+	}
+	return b
+}
+
+// WithRestartHistory adds the given value to the RestartHistory field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RestartHistory field.
+func (b *JobSetStatusApplyConfiguration) WithRestartHistory(values ...*RestartHistoryEntryApplyConfiguration) *JobSetStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithRestartHistory")
+		}
+		b.RestartHistory = append(b.RestartHistory, *values[i])
+	}
+	return b
+}
+
+// WithJobRestarts adds the given value to the JobRestarts field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the JobRestarts field.
+func (b *JobSetStatusApplyConfiguration) WithJobRestarts(values ...*JobRestartStatusApplyConfiguration) *JobSetStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithJobRestarts")
+		}
+		b.JobRestarts = append(b.JobRestarts, *values[i])
+	}
+	return b
+}
+
+// WithStartTime sets the StartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the StartTime field is set to the value of the last call.
+func (b *JobSetStatusApplyConfiguration) WithStartTime(value metav1.Time) *JobSetStatusApplyConfiguration {
+	b.StartTime = &value
+	return b
+}
+
+// WithCurrentAttemptStartTime sets the CurrentAttemptStartTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CurrentAttemptStartTime field is set to the value of the last call.
+func (b *JobSetStatusApplyConfiguration) WithCurrentAttemptStartTime(value metav1.Time) *JobSetStatusApplyConfiguration {
+	b.CurrentAttemptStartTime = &value
+	return b
+}
+
+// WithCompletionTime sets the CompletionTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CompletionTime field is set to the value of the last call.
+func (b *JobSetStatusApplyConfiguration) WithCompletionTime(value metav1.Time) *JobSetStatusApplyConfiguration {
+	b.CompletionTime = &value
+	return b
+}
+
+// WithSuspendedDuration sets the SuspendedDuration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SuspendedDuration field is set to the value of the last call.
+func (b *JobSetStatusApplyConfiguration) WithSuspendedDuration(value metav1.Duration) *JobSetStatusApplyConfiguration {
+	b.SuspendedDuration = &value
+	return b
+}
+
+// WithSuspendedSince sets the SuspendedSince field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SuspendedSince field is set to the value of the last call.
+func (b *JobSetStatusApplyConfiguration) WithSuspendedSince(value metav1.Time) *JobSetStatusApplyConfiguration {
+	b.SuspendedSince = &value
+	return b
+}
+
+// WithActiveDuration sets the ActiveDuration field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ActiveDuration field is set to the value of the last call.
+func (b *JobSetStatusApplyConfiguration) WithActiveDuration(value metav1.Duration) *JobSetStatusApplyConfiguration {
+	b.ActiveDuration = &value
+	return b
+}
+
+// WithScale sets the Scale field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Scale field is set to the value of the last call.
+func (b *JobSetStatusApplyConfiguration) WithScale(value *ReplicatedJobScaleStatusApplyConfiguration) *JobSetStatusApplyConfiguration {
+	b.Scale = value
+	return b
+}
@@ -17,9 +17,12 @@ package v1alpha2
 // NetworkApplyConfiguration represents a declarative configuration of the Network type for use
 // with apply.
 type NetworkApplyConfiguration struct {
-	EnableDNSHostnames       *bool   `json:"enableDNSHostnames,omitempty"`
-	Subdomain                *string `json:"subdomain,omitempty"`
-	PublishNotReadyAddresses *bool   `json:"publishNotReadyAddresses,omitempty"`
+	EnableDNSHostnames       *bool                               `json:"enableDNSHostnames,omitempty"`
+	Subdomain                *string                             `json:"subdomain,omitempty"`
+	PublishNotReadyAddresses *bool                               `json:"publishNotReadyAddresses,omitempty"`
+	Group                    *string                             `json:"group,omitempty"`
+	Ports                    []NetworkPortApplyConfiguration     `json:"ports,omitempty"`
+	Isolation                *NetworkIsolationApplyConfiguration `json:"isolation,omitempty"`
 }
 
 // NetworkApplyConfiguration constructs a declarative configuration of the Network type for use with
@@ -51,3 +54,32 @@ func (b *NetworkApplyConfiguration) WithPublishNotReadyAddresses(value bool) *Ne
 	b.PublishNotReadyAddresses = &value
 	return b
 }
+
+// WithGroup sets the Group field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Group field is set to the value of the last call.
+func (b *NetworkApplyConfiguration) WithGroup(value string) *NetworkApplyConfiguration {
+	b.Group = &value
+	return b
+}
+
+// WithPorts adds the given value to the Ports field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Ports field.
+func (b *NetworkApplyConfiguration) WithPorts(values ...*NetworkPortApplyConfiguration) *NetworkApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPorts")
+		}
+		b.Ports = append(b.Ports, *values[i])
+	}
+	return b
+}
+
+// WithIsolation sets the Isolation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Isolation field is set to the value of the last call.
+func (b *NetworkApplyConfiguration) WithIsolation(value *NetworkIsolationApplyConfiguration) *NetworkApplyConfiguration {
+	b.Isolation = value
+	return b
+}
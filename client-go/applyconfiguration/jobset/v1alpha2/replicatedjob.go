@@ -21,11 +21,15 @@ import (
 // ReplicatedJobApplyConfiguration represents a declarative configuration of the ReplicatedJob type for use
 // with apply.
 type ReplicatedJobApplyConfiguration struct {
-	Name      *string                               `json:"name,omitempty"`
-	GroupName *string                               `json:"groupName,omitempty"`
-	Template  *v1.JobTemplateSpecApplyConfiguration `json:"template,omitempty"`
-	Replicas  *int32                                `json:"replicas,omitempty"`
-	DependsOn []DependsOnApplyConfiguration         `json:"dependsOn,omitempty"`
+	Name              *string                                 `json:"name,omitempty"`
+	GroupName         *string                                 `json:"groupName,omitempty"`
+	Template          *v1.JobTemplateSpecApplyConfiguration   `json:"template,omitempty"`
+	Replicas          *int32                                  `json:"replicas,omitempty"`
+	DependsOn         []DependsOnApplyConfiguration           `json:"dependsOn,omitempty"`
+	Suspend           *bool                                   `json:"suspend,omitempty"`
+	Network           *ReplicatedJobNetworkApplyConfiguration `json:"network,omitempty"`
+	InjectTopologyEnv *bool                                   `json:"injectTopologyEnv,omitempty"`
+	Framework         *FrameworkApplyConfiguration            `json:"framework,omitempty"`
 }
 
 // ReplicatedJobApplyConfiguration constructs a declarative configuration of the ReplicatedJob type for use with
@@ -78,3 +82,35 @@ func (b *ReplicatedJobApplyConfiguration) WithDependsOn(values ...*DependsOnAppl
 	}
 	return b
 }
+
+// WithSuspend sets the Suspend field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Suspend field is set to the value of the last call.
+func (b *ReplicatedJobApplyConfiguration) WithSuspend(value bool) *ReplicatedJobApplyConfiguration {
+	b.Suspend = &value
+	return b
+}
+
+// WithNetwork sets the Network field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Network field is set to the value of the last call.
+func (b *ReplicatedJobApplyConfiguration) WithNetwork(value *ReplicatedJobNetworkApplyConfiguration) *ReplicatedJobApplyConfiguration {
+	b.Network = value
+	return b
+}
+
+// WithInjectTopologyEnv sets the InjectTopologyEnv field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InjectTopologyEnv field is set to the value of the last call.
+func (b *ReplicatedJobApplyConfiguration) WithInjectTopologyEnv(value bool) *ReplicatedJobApplyConfiguration {
+	b.InjectTopologyEnv = &value
+	return b
+}
+
+// WithFramework sets the Framework field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Framework field is set to the value of the last call.
+func (b *ReplicatedJobApplyConfiguration) WithFramework(value *FrameworkApplyConfiguration) *ReplicatedJobApplyConfiguration {
+	b.Framework = value
+	return b
+}
@@ -14,15 +14,24 @@ limitations under the License.
 
 package v1alpha2
 
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // ReplicatedJobStatusApplyConfiguration represents a declarative configuration of the ReplicatedJobStatus type for use
 // with apply.
 type ReplicatedJobStatusApplyConfiguration struct {
-	Name      *string `json:"name,omitempty"`
-	Ready     *int32  `json:"ready,omitempty"`
-	Succeeded *int32  `json:"succeeded,omitempty"`
-	Failed    *int32  `json:"failed,omitempty"`
-	Active    *int32  `json:"active,omitempty"`
-	Suspended *int32  `json:"suspended,omitempty"`
+	Name                    *string                       `json:"name,omitempty"`
+	Ready                   *int32                        `json:"ready,omitempty"`
+	Succeeded               *int32                        `json:"succeeded,omitempty"`
+	Failed                  *int32                        `json:"failed,omitempty"`
+	Active                  *int32                        `json:"active,omitempty"`
+	Suspended               *int32                        `json:"suspended,omitempty"`
+	Pods                    *PodsStatusApplyConfiguration `json:"pods,omitempty"`
+	Suspend                 *bool                         `json:"suspend,omitempty"`
+	Restarts                *int32                        `json:"restarts,omitempty"`
+	RestartsCountTowardsMax *int32                        `json:"restartsCountTowardsMax,omitempty"`
+	RestartTimes            []v1.Time                     `json:"restartTimes,omitempty"`
 }
 
 // ReplicatedJobStatusApplyConfiguration constructs a declarative configuration of the ReplicatedJobStatus type for use with
@@ -78,3 +87,45 @@ func (b *ReplicatedJobStatusApplyConfiguration) WithSuspended(value int32) *Repl
 	b.Suspended = &value
 	return b
 }
+
+// WithPods sets the Pods field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Pods field is set to the value of the last call.
+func (b *ReplicatedJobStatusApplyConfiguration) WithPods(value *PodsStatusApplyConfiguration) *ReplicatedJobStatusApplyConfiguration {
+	b.Pods = value
+	return b
+}
+
+// WithSuspend sets the Suspend field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Suspend field is set to the value of the last call.
+func (b *ReplicatedJobStatusApplyConfiguration) WithSuspend(value bool) *ReplicatedJobStatusApplyConfiguration {
+	b.Suspend = &value
+	return b
+}
+
+// WithRestarts sets the Restarts field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Restarts field is set to the value of the last call.
+func (b *ReplicatedJobStatusApplyConfiguration) WithRestarts(value int32) *ReplicatedJobStatusApplyConfiguration {
+	b.Restarts = &value
+	return b
+}
+
+// WithRestartsCountTowardsMax sets the RestartsCountTowardsMax field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RestartsCountTowardsMax field is set to the value of the last call.
+func (b *ReplicatedJobStatusApplyConfiguration) WithRestartsCountTowardsMax(value int32) *ReplicatedJobStatusApplyConfiguration {
+	b.RestartsCountTowardsMax = &value
+	return b
+}
+
+// WithRestartTimes adds the given value to the RestartTimes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the RestartTimes field.
+func (b *ReplicatedJobStatusApplyConfiguration) WithRestartTimes(values ...v1.Time) *ReplicatedJobStatusApplyConfiguration {
+	for i := range values {
+		b.RestartTimes = append(b.RestartTimes, values[i])
+	}
+	return b
+}
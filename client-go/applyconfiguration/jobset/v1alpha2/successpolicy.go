@@ -22,6 +22,7 @@ import (
 // with apply.
 type SuccessPolicyApplyConfiguration struct {
 	Operator             *jobsetv1alpha2.Operator `json:"operator,omitempty"`
+	MinSucceeded         *int32                   `json:"minSucceeded,omitempty"`
 	TargetReplicatedJobs []string                 `json:"targetReplicatedJobs,omitempty"`
 }
 
@@ -39,6 +40,14 @@ func (b *SuccessPolicyApplyConfiguration) WithOperator(value jobsetv1alpha2.Oper
 	return b
 }
 
+// WithMinSucceeded sets the MinSucceeded field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MinSucceeded field is set to the value of the last call.
+func (b *SuccessPolicyApplyConfiguration) WithMinSucceeded(value int32) *SuccessPolicyApplyConfiguration {
+	b.MinSucceeded = &value
+	return b
+}
+
 // WithTargetReplicatedJobs adds the given value to the TargetReplicatedJobs field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the TargetReplicatedJobs field.
@@ -30,12 +30,18 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 	// Group=jobset.x-k8s.io, Version=v1alpha2
 	case v1alpha2.SchemeGroupVersion.WithKind("Coordinator"):
 		return &jobsetv1alpha2.CoordinatorApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("CoordinatorService"):
+		return &jobsetv1alpha2.CoordinatorServiceApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("DependsOn"):
 		return &jobsetv1alpha2.DependsOnApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("FailurePolicy"):
 		return &jobsetv1alpha2.FailurePolicyApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("FailurePolicyRule"):
 		return &jobsetv1alpha2.FailurePolicyRuleApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("Framework"):
+		return &jobsetv1alpha2.FrameworkApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("JobRestartStatus"):
+		return &jobsetv1alpha2.JobRestartStatusApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("JobSet"):
 		return &jobsetv1alpha2.JobSetApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("JobSetSpec"):
@@ -44,10 +50,28 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &jobsetv1alpha2.JobSetStatusApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("Network"):
 		return &jobsetv1alpha2.NetworkApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("NetworkIsolation"):
+		return &jobsetv1alpha2.NetworkIsolationApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("NetworkPort"):
+		return &jobsetv1alpha2.NetworkPortApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("PodsStatus"):
+		return &jobsetv1alpha2.PodsStatusApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("ReplicatedJob"):
 		return &jobsetv1alpha2.ReplicatedJobApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("ReplicatedJobMaxRestarts"):
+		return &jobsetv1alpha2.ReplicatedJobMaxRestartsApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("ReplicatedJobNetwork"):
+		return &jobsetv1alpha2.ReplicatedJobNetworkApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("ReplicatedJobScale"):
+		return &jobsetv1alpha2.ReplicatedJobScaleApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("ReplicatedJobScaleStatus"):
+		return &jobsetv1alpha2.ReplicatedJobScaleStatusApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("ReplicatedJobStatus"):
 		return &jobsetv1alpha2.ReplicatedJobStatusApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("RestartBackoff"):
+		return &jobsetv1alpha2.RestartBackoffApplyConfiguration{}
+	case v1alpha2.SchemeGroupVersion.WithKind("RestartHistoryEntry"):
+		return &jobsetv1alpha2.RestartHistoryEntryApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("StartupPolicy"):
 		return &jobsetv1alpha2.StartupPolicyApplyConfiguration{}
 	case v1alpha2.SchemeGroupVersion.WithKind("SuccessPolicy"):
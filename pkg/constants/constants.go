@@ -25,7 +25,8 @@ const (
 	JobOwnerKey = ".metadata.controller"
 
 	// RestartsKey is an annotation and label key which defines the restart attempt number
-	// the JobSet is currently on.
+	// the JobSet is currently on. When the RecreateFailedJobs restart strategy is used,
+	// it defines the restart attempt number of the child Job instead.
 	RestartsKey = "jobset.sigs.k8s.io/restart-attempt"
 
 	// PriorityKey is a label key to record the pod priority. This is needed to enforce exclusive placement
@@ -55,10 +56,32 @@ const (
 	// The event uses the error(s) as the message.
 	JobCreationFailedReason = "JobCreationFailed"
 
+	// Event reason used when a Job cannot be created because a Job with the same name already
+	// exists and cannot be adopted by the JobSet. The event uses the error as the message.
+	JobNameConflictReason = "JobNameConflict"
+
 	// Event reason used when a Headless Service creation fails.
 	// The event uses the error(s) as the message.
 	HeadlessServiceCreationFailedReason = "HeadlessServiceCreationFailed"
 
+	// Event reason used when the network policy managed by the JobSet conflicts with an existing
+	// network policy. The event uses the conflict as the message.
+	NetworkPolicyConflictReason = "NetworkPolicyConflict"
+
+	// Event reason and message for when the network policy managed by the JobSet no longer
+	// conflicts with an existing network policy.
+	NetworkPolicyReconciledReason  = "NetworkPolicyReconciled"
+	NetworkPolicyReconciledMessage = "network policy is reconciled"
+
+	// Event reason used when a service managed by the JobSet conflicts with an existing service.
+	// The event uses the conflict as the message.
+	ServiceConflictReason = "ServiceConflict"
+
+	// Event reason and message for when the services managed by the JobSet no longer conflict
+	// with existing services.
+	ServiceReconciledReason  = "ServiceReconciled"
+	ServiceReconciledMessage = "services are reconciled"
+
 	// Event reason and message for when the pod controller detects a violation
 	// of the JobSet exclusive placment policy (i.e., follower pods not colocated in
 	// the same topology domain as the leader pod for that Job).
@@ -94,4 +117,36 @@ const (
 	// Event reason and message related to applying the RestartJobSetAndIgnoreMaxRestarts failure policy action.
 	RestartJobSetAndIgnoreMaxRestartsActionReason  = "RestartJobSetAndIgnoreMaxRestartsFailurePolicyAction"
 	RestartJobSetAndIgnoreMaxRestartsActionMessage = "applying RestartJobSetAndIgnoreMaxRestarts failure policy action"
+
+	// Event reason and message related to applying the SuspendJobSet failure policy action.
+	SuspendJobSetActionReason  = "SuspendedByFailurePolicy"
+	SuspendJobSetActionMessage = "applying SuspendJobSet failure policy action, jobset is held until resumed"
+
+	// Event reason and message related to resuming a JobSet held by the SuspendJobSet failure policy action.
+	ResumedAfterSuspendJobSetActionReason  = "ResumedAfterFailurePolicyHold"
+	ResumedAfterSuspendJobSetActionMessage = "jobset held by the SuspendJobSet failure policy action is resumed"
+
+	// Event reason and message for when a JobSet fails due to exceeding its active deadline.
+	DeadlineExceededReason  = "DeadlineExceeded"
+	DeadlineExceededMessage = "jobset was active longer than specified deadline"
+
+	// Event reason and message for when all the child jobs of the JobSet are ready.
+	AllJobsReadyReason  = "AllJobsReady"
+	AllJobsReadyMessage = "all jobs are ready"
+
+	// Event reason and message for when not all the child jobs of the JobSet are ready.
+	JobsNotReadyReason  = "JobsNotReady"
+	JobsNotReadyMessage = "not all jobs are ready"
+
+	// Event reason and message for when the child jobs of the JobSet are being created and started.
+	CreatingJobsReason  = "CreatingJobs"
+	CreatingJobsMessage = "jobs are being created and started"
+
+	// Event reason and message for when the child jobs of the JobSet are being recreated after a restart.
+	RestartingJobsReason  = "RestartingJobs"
+	RestartingJobsMessage = "jobs are being recreated after a restart"
+
+	// Event reason and message for when the JobSet is no longer progressing as it is finished.
+	JobSetFinishedReason  = "JobSetFinished"
+	JobSetFinishedMessage = "jobset is finished"
 )
@@ -0,0 +1,106 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	"sigs.k8s.io/jobset/pkg/constants"
+)
+
+// updateActiveTime tracks the time during which the JobSet has been active in its status:
+//  1. startTime and currentAttemptStartTime are set the first time the JobSet runs while not suspended.
+//  2. suspendedSince is set when the JobSet is suspended after it started.
+//  3. suspendedDuration accumulates the time the JobSet was suspended when it is resumed.
+func updateActiveTime(clock clock.Clock, js *jobset.JobSet, updateStatusOpts *statusUpdateOpts) {
+	now := metav1.NewTime(clock.Now())
+	suspended := jobSetSuspended(js)
+	switch {
+	case js.Status.StartTime == nil:
+		if suspended {
+			return
+		}
+		js.Status.StartTime = &now
+		js.Status.CurrentAttemptStartTime = &now
+	case suspended && js.Status.SuspendedSince == nil:
+		js.Status.SuspendedSince = &now
+	case !suspended && js.Status.SuspendedSince != nil:
+		suspendedDuration := now.Sub(js.Status.SuspendedSince.Time)
+		if js.Status.SuspendedDuration != nil {
+			suspendedDuration += js.Status.SuspendedDuration.Duration
+		}
+		js.Status.SuspendedDuration = &metav1.Duration{Duration: suspendedDuration}
+		js.Status.SuspendedSince = nil
+	default:
+		return
+	}
+	updateStatusOpts.shouldUpdate = true
+}
+
+// activeDuration returns the time the JobSet has been active since its first start,
+// excluding the time during which it was suspended.
+func activeDuration(js *jobset.JobSet, now time.Time) time.Duration {
+	if js.Status.StartTime == nil {
+		return 0
+	}
+	active := now.Sub(js.Status.StartTime.Time)
+	if js.Status.SuspendedDuration != nil {
+		active -= js.Status.SuspendedDuration.Duration
+	}
+	if js.Status.SuspendedSince != nil {
+		active -= now.Sub(js.Status.SuspendedSince.Time)
+	}
+	return max(active, 0)
+}
+
+// executeActiveDeadlinePolicy fails the JobSet if it has been active for longer than its
+// activeDeadlineSeconds, in which case it returns true.
+// If the deadline has not been reached, it returns the time after which the JobSet should be requeued.
+// If the JobSet does not have an activeDeadlineSeconds set, or is suspended, it returns 0.
+func executeActiveDeadlinePolicy(ctx context.Context, clock clock.Clock, js *jobset.JobSet, updateStatusOpts *statusUpdateOpts) (time.Duration, bool) {
+	log := ctrl.LoggerFrom(ctx)
+
+	// The deadline does not progress while the JobSet is suspended, and resuming it triggers a reconciliation.
+	if js.Spec.ActiveDeadlineSeconds == nil || js.Status.StartTime == nil || jobSetSuspended(js) {
+		return 0, false
+	}
+
+	deadline := time.Duration(*js.Spec.ActiveDeadlineSeconds) * time.Second
+	remaining := deadline - activeDuration(js, clock.Now())
+	if remaining > 0 {
+		return remaining, false
+	}
+
+	log.V(2).Info("JobSet active deadline exceeded, failing", "activeDeadlineSeconds", *js.Spec.ActiveDeadlineSeconds)
+	setJobSetFailedCondition(clock, js, constants.DeadlineExceededReason, constants.DeadlineExceededMessage, updateStatusOpts)
+	return 0, true
+}
+
+// shortestRequeueAfter returns the shortest of the given non-zero requeue delays,
+// or zero if none of them is set.
+func shortestRequeueAfter(requeueAfters ...time.Duration) time.Duration {
+	var shortest time.Duration
+	for _, requeueAfter := range requeueAfters {
+		if requeueAfter > 0 && (shortest == 0 || requeueAfter < shortest) {
+			shortest = requeueAfter
+		}
+	}
+	return shortest
+}
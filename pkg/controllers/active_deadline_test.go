@@ -0,0 +1,230 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2/ktesting"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	"sigs.k8s.io/jobset/pkg/constants"
+	testutils "sigs.k8s.io/jobset/pkg/util/testing"
+)
+
+func TestUpdateActiveTime(t *testing.T) {
+	var (
+		jobSetName = "test-jobset"
+		ns         = "default"
+	)
+
+	now := time.Now().Truncate(time.Second)
+	ago := func(d time.Duration) *metav1.Time {
+		return &metav1.Time{Time: now.Add(-d)}
+	}
+
+	tests := []struct {
+		name             string
+		suspend          bool
+		status           jobset.JobSetStatus
+		wantStatus       jobset.JobSetStatus
+		wantShouldUpdate bool
+	}{
+		{
+			name:       "start time is not set while the jobset is suspended",
+			suspend:    true,
+			wantStatus: jobset.JobSetStatus{},
+		},
+		{
+			name:             "start time is set when the jobset first runs",
+			wantStatus:       jobset.JobSetStatus{StartTime: &metav1.Time{Time: now}, CurrentAttemptStartTime: &metav1.Time{Time: now}},
+			wantShouldUpdate: true,
+		},
+		{
+			name:       "start time is not reset",
+			status:     jobset.JobSetStatus{StartTime: ago(time.Hour)},
+			wantStatus: jobset.JobSetStatus{StartTime: ago(time.Hour)},
+		},
+		{
+			name:    "suspension of a started jobset is tracked",
+			suspend: true,
+			status:  jobset.JobSetStatus{StartTime: ago(time.Hour)},
+			wantStatus: jobset.JobSetStatus{
+				StartTime:      ago(time.Hour),
+				SuspendedSince: &metav1.Time{Time: now},
+			},
+			wantShouldUpdate: true,
+		},
+		{
+			name: "suspended duration is accumulated when the jobset is resumed",
+			status: jobset.JobSetStatus{
+				StartTime:         ago(time.Hour),
+				SuspendedDuration: &metav1.Duration{Duration: 5 * time.Minute},
+				SuspendedSince:    ago(10 * time.Minute),
+			},
+			wantStatus: jobset.JobSetStatus{
+				StartTime:         ago(time.Hour),
+				SuspendedDuration: &metav1.Duration{Duration: 15 * time.Minute},
+			},
+			wantShouldUpdate: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			js := testutils.MakeJobSet(jobSetName, ns).
+				Suspend(tc.suspend).
+				SetStatus(tc.status).
+				Obj()
+			opts := &statusUpdateOpts{}
+
+			updateActiveTime(clocktesting.NewFakeClock(now), js, opts)
+			if diff := cmp.Diff(tc.wantStatus, js.Status); diff != "" {
+				t.Errorf("unexpected status (-want/+got): %s", diff)
+			}
+			if opts.shouldUpdate != tc.wantShouldUpdate {
+				t.Errorf("unexpected shouldUpdate: want %v, got %v", tc.wantShouldUpdate, opts.shouldUpdate)
+			}
+		})
+	}
+}
+
+func TestExecuteActiveDeadlinePolicy(t *testing.T) {
+	var (
+		jobSetName = "test-jobset"
+		ns         = "default"
+	)
+
+	now := time.Now().Truncate(time.Second)
+	ago := func(d time.Duration) *metav1.Time {
+		return &metav1.Time{Time: now.Add(-d)}
+	}
+
+	tests := []struct {
+		name                 string
+		activeDeadline       *int64
+		suspend              bool
+		status               jobset.JobSetStatus
+		wantRequeueAfter     time.Duration
+		wantDeadlineExceeded bool
+	}{
+		{
+			name:   "active deadline not set",
+			status: jobset.JobSetStatus{StartTime: ago(time.Hour)},
+		},
+		{
+			name:           "jobset not started",
+			activeDeadline: ptr.To[int64](60),
+		},
+		{
+			name:             "deadline not reached",
+			activeDeadline:   ptr.To[int64](3600),
+			status:           jobset.JobSetStatus{StartTime: ago(40 * time.Minute)},
+			wantRequeueAfter: 20 * time.Minute,
+		},
+		{
+			name:                 "deadline exceeded",
+			activeDeadline:       ptr.To[int64](3600),
+			status:               jobset.JobSetStatus{StartTime: ago(2 * time.Hour)},
+			wantDeadlineExceeded: true,
+		},
+		{
+			name:           "suspended time does not count towards the deadline",
+			activeDeadline: ptr.To[int64](3600),
+			status: jobset.JobSetStatus{
+				StartTime:         ago(2 * time.Hour),
+				SuspendedDuration: &metav1.Duration{Duration: 90 * time.Minute},
+			},
+			wantRequeueAfter: 30 * time.Minute,
+		},
+		{
+			name:           "deadline does not progress while the jobset is suspended",
+			activeDeadline: ptr.To[int64](3600),
+			suspend:        true,
+			status: jobset.JobSetStatus{
+				StartTime:      ago(2 * time.Hour),
+				SuspendedSince: ago(90 * time.Minute),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			js := testutils.MakeJobSet(jobSetName, ns).
+				Suspend(tc.suspend).
+				SetStatus(tc.status).
+				Obj()
+			js.Spec.ActiveDeadlineSeconds = tc.activeDeadline
+			opts := &statusUpdateOpts{}
+
+			gotRequeueAfter, gotDeadlineExceeded := executeActiveDeadlinePolicy(ctx, clocktesting.NewFakeClock(now), js, opts)
+			if gotRequeueAfter != tc.wantRequeueAfter {
+				t.Errorf("unexpected requeue after: want %v, got %v", tc.wantRequeueAfter, gotRequeueAfter)
+			}
+			if gotDeadlineExceeded != tc.wantDeadlineExceeded {
+				t.Errorf("unexpected deadline exceeded: want %v, got %v", tc.wantDeadlineExceeded, gotDeadlineExceeded)
+			}
+			if tc.wantDeadlineExceeded {
+				if js.Status.TerminalState != string(jobset.JobSetFailed) {
+					t.Errorf("expected jobset to be failed, got terminal state %q", js.Status.TerminalState)
+				}
+				if len(js.Status.Conditions) != 1 || js.Status.Conditions[0].Reason != constants.DeadlineExceededReason {
+					t.Errorf("expected failed condition with reason %s, got %v", constants.DeadlineExceededReason, js.Status.Conditions)
+				}
+			}
+		})
+	}
+}
+
+func TestShortestRequeueAfter(t *testing.T) {
+	tests := []struct {
+		name          string
+		requeueAfters []time.Duration
+		want          time.Duration
+	}{
+		{
+			name:          "no requeue",
+			requeueAfters: []time.Duration{0, 0},
+		},
+		{
+			name:          "only the deadline requeue is set",
+			requeueAfters: []time.Duration{0, time.Minute},
+			want:          time.Minute,
+		},
+		{
+			name:          "the restart backoff is shorter than the deadline",
+			requeueAfters: []time.Duration{10 * time.Second, time.Minute},
+			want:          10 * time.Second,
+		},
+		{
+			name:          "the deadline is shorter than the restart backoff",
+			requeueAfters: []time.Duration{time.Hour, time.Minute},
+			want:          time.Minute,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := shortestRequeueAfter(tc.requeueAfters...); got != tc.want {
+				t.Errorf("unexpected requeue after: want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
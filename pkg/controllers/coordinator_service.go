@@ -0,0 +1,61 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"fmt"
+	"strconv"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+)
+
+// CoordinatorSvcName returns the name of the ClusterIP service of the coordinator pod of the JobSet.
+func CoordinatorSvcName(js *jobset.JobSet) string {
+	return fmt.Sprintf("%s-coordinator", js.Name)
+}
+
+// constructCoordinatorSvc returns the ClusterIP service selecting only the coordinator pod of the
+// JobSet, or nil if the JobSet has no coordinator service. The ports of the service default to the
+// network ports of the JobSet.
+func constructCoordinatorSvc(js *jobset.JobSet) *corev1.Service {
+	coordinator := js.Spec.Coordinator
+	if coordinator == nil || coordinator.Service == nil {
+		return nil
+	}
+	ports := coordinator.Service.Ports
+	if len(ports) == 0 && js.Spec.Network != nil {
+		ports = js.Spec.Network.Ports
+	}
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      CoordinatorSvcName(js),
+			Namespace: js.Namespace,
+		},
+		Spec: corev1.ServiceSpec{
+			Type: corev1.ServiceTypeClusterIP,
+			// The completion index label is set on the pods of indexed Jobs along with the annotation.
+			Selector: map[string]string{
+				jobset.JobSetNameKey:                 js.Name,
+				jobset.ReplicatedJobNameKey:          coordinator.ReplicatedJob,
+				jobset.JobIndexKey:                   strconv.Itoa(coordinator.JobIndex),
+				batchv1.JobCompletionIndexAnnotation: strconv.Itoa(coordinator.PodIndex),
+			},
+			Ports: svcPorts(ports),
+		},
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2/ktesting"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	testutils "sigs.k8s.io/jobset/pkg/util/testing"
+)
+
+func TestConstructCoordinatorSvc(t *testing.T) {
+	var (
+		jobSetName = "test-jobset"
+		ns         = "default"
+	)
+
+	selector := map[string]string{
+		jobset.JobSetNameKey:                       jobSetName,
+		jobset.ReplicatedJobNameKey:                "driver",
+		jobset.JobIndexKey:                         "0",
+		"batch.kubernetes.io/job-completion-index": "1",
+	}
+	makeService := func(ports ...corev1.ServicePort) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-jobset-coordinator",
+				Namespace: ns,
+			},
+			Spec: corev1.ServiceSpec{
+				Type:     corev1.ServiceTypeClusterIP,
+				Selector: selector,
+				Ports:    ports,
+			},
+		}
+	}
+	coordinator := func(service *jobset.CoordinatorService) *jobset.Coordinator {
+		return &jobset.Coordinator{ReplicatedJob: "driver", JobIndex: 0, PodIndex: 1, Service: service}
+	}
+	networkPort := jobset.NetworkPort{Name: "dashboard", Port: 8265}
+
+	tests := []struct {
+		name string
+		js   *jobset.JobSet
+		want *corev1.Service
+	}{
+		{
+			name: "no coordinator",
+			js:   testutils.MakeJobSet(jobSetName, ns).NetworkPorts(networkPort).Obj(),
+		},
+		{
+			name: "coordinator without service",
+			js:   testutils.MakeJobSet(jobSetName, ns).Coordinator(coordinator(nil)).NetworkPorts(networkPort).Obj(),
+		},
+		{
+			name: "coordinator service defaults to the network ports",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				Coordinator(coordinator(&jobset.CoordinatorService{})).
+				NetworkPorts(networkPort).
+				Obj(),
+			want: makeService(corev1.ServicePort{Name: "dashboard", Port: 8265, TargetPort: intstr.FromInt32(8265), Protocol: corev1.ProtocolTCP}),
+		},
+		{
+			name: "coordinator service with its own ports",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				Coordinator(coordinator(&jobset.CoordinatorService{
+					Ports: []jobset.NetworkPort{{Name: "tensorboard", Port: 80, TargetPort: ptr.To(intstr.FromInt32(6006))}},
+				})).
+				NetworkPorts(networkPort).
+				Obj(),
+			want: makeService(corev1.ServicePort{Name: "tensorboard", Port: 80, TargetPort: intstr.FromInt32(6006), Protocol: corev1.ProtocolTCP}),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, constructCoordinatorSvc(tc.js)); diff != "" {
+				t.Errorf("unexpected coordinator service (-want/+got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestReconcileCoordinatorSvc(t *testing.T) {
+	_, ctx := ktesting.NewTestContext(t)
+	scheme := runtime.NewScheme()
+	utilruntime.Must(jobset.AddToScheme(scheme))
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	r := &JobSetReconciler{
+		Client: fakeClient,
+		Scheme: scheme,
+		Record: record.NewFakeRecorder(10),
+	}
+
+	js := testutils.MakeJobSet("test-jobset", "default").
+		EnableDNSHostnames(false).
+		Coordinator(&jobset.Coordinator{ReplicatedJob: "driver", Service: &jobset.CoordinatorService{}}).
+		NetworkPorts(jobset.NetworkPort{Name: "dashboard", Port: 8265}).
+		Obj()
+	js.UID = "test-jobset-uid"
+	if err := r.reconcileSvcs(ctx, js, &statusUpdateOpts{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var svcs corev1.ServiceList
+	if err := fakeClient.List(ctx, &svcs); err != nil {
+		t.Fatalf("unexpected error listing the services: %v", err)
+	}
+	if len(svcs.Items) != 1 {
+		t.Fatalf("expected only the coordinator service to be created, got %d services", len(svcs.Items))
+	}
+	svc := svcs.Items[0]
+	if svc.Name != "test-jobset-coordinator" {
+		t.Errorf("expected the coordinator service to be named %q, got %q", "test-jobset-coordinator", svc.Name)
+	}
+	if !metav1.IsControlledBy(&svc, js) {
+		t.Errorf("expected the coordinator service to be controlled by the jobset, got owner references %v", svc.OwnerReferences)
+	}
+	if svc.Spec.Type != corev1.ServiceTypeClusterIP || svc.Spec.ClusterIP == corev1.ClusterIPNone {
+		t.Errorf("expected the coordinator service to be a ClusterIP service, got type %q and cluster IP %q", svc.Spec.Type, svc.Spec.ClusterIP)
+	}
+}
@@ -1,11 +1,81 @@
 package controllers
 
 import (
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
 	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 )
 
-// dependencyReachedStatus checks if dependant ReplicatedJob reaches Ready or Complete status.
-// func dependencyReachedStatus(dependsOnJob jobset.DependsOn, dependsOnJobReplicas int32, rJobsStatuses []jobset.ReplicatedJobStatus) bool {
+// evaluateDependencies walks the ReplicatedJobs in topological order of their DependsOn graph, and returns
+// them in that order along with the names of the ReplicatedJobs whose dependencies are reached.
+// The dependencies of a ReplicatedJob are reached if every ReplicatedJob it depends on has its own
+// dependencies reached and has reached the status set in the DependsOn item.
+func evaluateDependencies(js *jobset.JobSet, rJobsStatuses []jobset.ReplicatedJobStatus) ([]jobset.ReplicatedJob, sets.Set[string]) {
+	// Map where key is the ReplicatedJob name and value is the ReplicatedJob replicas.
+	rJobReplicas := map[string]int32{}
+	for _, rJob := range js.Spec.ReplicatedJobs {
+		rJobReplicas[rJob.Name] = rJob.Replicas
+	}
+
+	ordered := topologicalOrder(js.Spec.ReplicatedJobs)
+	reached := sets.New[string]()
+	for _, rJob := range ordered {
+		predecessorsReached := true
+		for _, dependsOnJob := range rJob.DependsOn {
+			if !reached.Has(dependsOnJob.Name) {
+				predecessorsReached = false
+				break
+			}
+		}
+		if predecessorsReached && dependencyReachedStatus(rJob, rJobReplicas, rJobsStatuses) {
+			reached.Insert(rJob.Name)
+		}
+	}
+	return ordered, reached
+}
+
+// topologicalOrder returns the ReplicatedJobs sorted so that each ReplicatedJob comes after the
+// ReplicatedJobs it depends on. ReplicatedJobs without dependencies between them keep the order of the
+// JobSet spec. ReplicatedJobs which are part of a dependency cycle, or depend on an unknown ReplicatedJob,
+// are rejected by the webhook, and are appended at the end in the order of the JobSet spec.
+func topologicalOrder(rJobs []jobset.ReplicatedJob) []jobset.ReplicatedJob {
+	ordered := make([]jobset.ReplicatedJob, 0, len(rJobs))
+	visited := sets.New[string]()
+	// Each pass appends the ReplicatedJobs whose dependencies have all been visited, in spec order.
+	for progress := true; progress; {
+		progress = false
+		for _, rJob := range rJobs {
+			if visited.Has(rJob.Name) {
+				continue
+			}
+			ready := true
+			for _, dependsOnJob := range rJob.DependsOn {
+				if !visited.Has(dependsOnJob.Name) {
+					ready = false
+					break
+				}
+			}
+			if !ready {
+				continue
+			}
+			ordered = append(ordered, rJob)
+			visited.Insert(rJob.Name)
+			progress = true
+		}
+	}
+
+	for _, rJob := range rJobs {
+		if !visited.Has(rJob.Name) {
+			ordered = append(ordered, rJob)
+		}
+	}
+	return ordered
+}
+
+// dependencyReachedStatus checks if every ReplicatedJob the given ReplicatedJob depends on
+// reaches its Ready or Complete status.
 func dependencyReachedStatus(rJob jobset.ReplicatedJob, rJobReplicas map[string]int32, rJobsStatuses []jobset.ReplicatedJobStatus) bool {
 	for _, dependsOnJob := range rJob.DependsOn {
 		// If the actual status of dependant ReplicatedJob is empty, return false.
@@ -23,7 +93,54 @@ func dependencyReachedStatus(rJob jobset.ReplicatedJob, rJobReplicas map[string]
 		if dependsOnJob.Status == jobset.DependencyReady && rJobReplicas[dependsOnJob.Name] != actualStatus.Failed+actualStatus.Ready+actualStatus.Succeeded {
 			return false
 		}
+
+		// For Finished status, all Jobs must have succeeded or at least one Job must have failed.
+		if dependsOnJob.Status == jobset.DependencyFinished && !replicatedJobFinished(rJobReplicas[dependsOnJob.Name], actualStatus) {
+			return false
+		}
+
+		// For Failed status, at least one Job must have failed.
+		if dependsOnJob.Status == jobset.DependencyFailed && actualStatus.Failed == 0 {
+			return false
+		}
 	}
 
 	return true
 }
+
+// replicatedJobFinished returns true if all the Jobs of the ReplicatedJob have succeeded,
+// or at least one of them has failed.
+func replicatedJobFinished(replicas int32, status *jobset.ReplicatedJobStatus) bool {
+	return status.Succeeded == replicas || status.Failed > 0
+}
+
+// cleanupReplicatedJob returns true if the ReplicatedJob depends on the Finished or Failed status
+// of another ReplicatedJob.
+func cleanupReplicatedJob(rJob *jobset.ReplicatedJob) bool {
+	return slices.ContainsFunc(rJob.DependsOn, func(dependsOnJob jobset.DependsOn) bool {
+		return dependsOnJob.Status == jobset.DependencyFinished || dependsOnJob.Status == jobset.DependencyFailed
+	})
+}
+
+// onFailureReplicatedJob returns true if the ReplicatedJob only runs when another ReplicatedJob has failed.
+func onFailureReplicatedJob(rJob *jobset.ReplicatedJob) bool {
+	return slices.ContainsFunc(rJob.DependsOn, func(dependsOnJob jobset.DependsOn) bool {
+		return dependsOnJob.Status == jobset.DependencyFailed
+	})
+}
+
+// cleanupReplicatedJobsPending returns true if a cleanup ReplicatedJob has its dependencies reached,
+// but has not finished yet. The terminal state of the JobSet is deferred until it finishes.
+func cleanupReplicatedJobsPending(js *jobset.JobSet, rJobsStatuses []jobset.ReplicatedJobStatus) bool {
+	_, dependenciesReached := evaluateDependencies(js, rJobsStatuses)
+	for _, rJob := range js.Spec.ReplicatedJobs {
+		if !cleanupReplicatedJob(&rJob) || !dependenciesReached.Has(rJob.Name) {
+			continue
+		}
+		status := findReplicatedJobStatus(rJobsStatuses, rJob.Name)
+		if status == nil || !replicatedJobFinished(rJob.Replicas, status) {
+			return true
+		}
+	}
+	return false
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 	testutils "sigs.k8s.io/jobset/pkg/util/testing"
@@ -324,6 +325,76 @@ func TestDependencyReachedStatus(t *testing.T) {
 			},
 			expected: false,
 		},
+		{
+			name: "depends on ReplicatedJob reaches finished status when complete",
+			rJob: testutils.MakeReplicatedJob(rJobTrainer).
+				DependsOn([]jobset.DependsOn{{Name: rJobModelInitializer, Status: jobset.DependencyFinished}}).
+				Obj(),
+			rJobReplicas: map[string]int32{
+				rJobModelInitializer: 2,
+				rJobTrainer:          1,
+			},
+			rJobsStatuses: []jobset.ReplicatedJobStatus{
+				{Name: rJobModelInitializer, Succeeded: 2},
+			},
+			expected: true,
+		},
+		{
+			name: "depends on ReplicatedJob reaches finished status when a job failed",
+			rJob: testutils.MakeReplicatedJob(rJobTrainer).
+				DependsOn([]jobset.DependsOn{{Name: rJobModelInitializer, Status: jobset.DependencyFinished}}).
+				Obj(),
+			rJobReplicas: map[string]int32{
+				rJobModelInitializer: 2,
+				rJobTrainer:          1,
+			},
+			rJobsStatuses: []jobset.ReplicatedJobStatus{
+				{Name: rJobModelInitializer, Failed: 1, Active: 1},
+			},
+			expected: true,
+		},
+		{
+			name: "depends on ReplicatedJob doesn't reach finished status while running",
+			rJob: testutils.MakeReplicatedJob(rJobTrainer).
+				DependsOn([]jobset.DependsOn{{Name: rJobModelInitializer, Status: jobset.DependencyFinished}}).
+				Obj(),
+			rJobReplicas: map[string]int32{
+				rJobModelInitializer: 2,
+				rJobTrainer:          1,
+			},
+			rJobsStatuses: []jobset.ReplicatedJobStatus{
+				{Name: rJobModelInitializer, Succeeded: 1, Active: 1},
+			},
+			expected: false,
+		},
+		{
+			name: "depends on ReplicatedJob reaches failed status",
+			rJob: testutils.MakeReplicatedJob(rJobTrainer).
+				DependsOn([]jobset.DependsOn{{Name: rJobModelInitializer, Status: jobset.DependencyFailed}}).
+				Obj(),
+			rJobReplicas: map[string]int32{
+				rJobModelInitializer: 2,
+				rJobTrainer:          1,
+			},
+			rJobsStatuses: []jobset.ReplicatedJobStatus{
+				{Name: rJobModelInitializer, Failed: 1, Active: 1},
+			},
+			expected: true,
+		},
+		{
+			name: "depends on ReplicatedJob doesn't reach failed status when complete",
+			rJob: testutils.MakeReplicatedJob(rJobTrainer).
+				DependsOn([]jobset.DependsOn{{Name: rJobModelInitializer, Status: jobset.DependencyFailed}}).
+				Obj(),
+			rJobReplicas: map[string]int32{
+				rJobModelInitializer: 2,
+				rJobTrainer:          1,
+			},
+			rJobsStatuses: []jobset.ReplicatedJobStatus{
+				{Name: rJobModelInitializer, Succeeded: 2},
+			},
+			expected: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -334,3 +405,227 @@ func TestDependencyReachedStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestEvaluateDependencies(t *testing.T) {
+	var (
+		jobSetName = "test-jobset"
+		ns         = "default"
+	)
+
+	rJob := func(name string, replicas int32, dependsOn ...jobset.DependsOn) jobset.ReplicatedJob {
+		return testutils.MakeReplicatedJob(name).
+			Replicas(replicas).
+			DependsOn(dependsOn).
+			Obj()
+	}
+
+	tests := []struct {
+		name          string
+		rJobs         []jobset.ReplicatedJob
+		rJobsStatuses []jobset.ReplicatedJobStatus
+		wantOrder     []string
+		wantReached   []string
+	}{
+		{
+			name: "no dependencies keep the spec order",
+			rJobs: []jobset.ReplicatedJob{
+				rJob("a", 1),
+				rJob("b", 1),
+			},
+			wantOrder:   []string{"a", "b"},
+			wantReached: []string{"a", "b"},
+		},
+		{
+			name: "dependency defined later in the spec comes first",
+			rJobs: []jobset.ReplicatedJob{
+				rJob("trainer", 2, jobset.DependsOn{Name: "initializer", Status: jobset.DependencyComplete}),
+				rJob("initializer", 1),
+			},
+			rJobsStatuses: []jobset.ReplicatedJobStatus{
+				{Name: "initializer", Succeeded: 1},
+			},
+			wantOrder:   []string{"initializer", "trainer"},
+			wantReached: []string{"initializer", "trainer"},
+		},
+		{
+			name: "fan-in with mixed Ready and Complete dependencies",
+			rJobs: []jobset.ReplicatedJob{
+				rJob("model-initializer", 1),
+				rJob("dataset-initializer", 2),
+				rJob("trainer", 4,
+					jobset.DependsOn{Name: "model-initializer", Status: jobset.DependencyComplete},
+					jobset.DependsOn{Name: "dataset-initializer", Status: jobset.DependencyReady},
+				),
+			},
+			rJobsStatuses: []jobset.ReplicatedJobStatus{
+				{Name: "model-initializer", Succeeded: 1},
+				{Name: "dataset-initializer", Ready: 1, Succeeded: 1},
+			},
+			wantOrder:   []string{"model-initializer", "dataset-initializer", "trainer"},
+			wantReached: []string{"model-initializer", "dataset-initializer", "trainer"},
+		},
+		{
+			name: "fan-in waits for every dependency",
+			rJobs: []jobset.ReplicatedJob{
+				rJob("model-initializer", 1),
+				rJob("dataset-initializer", 2),
+				rJob("trainer", 4,
+					jobset.DependsOn{Name: "model-initializer", Status: jobset.DependencyComplete},
+					jobset.DependsOn{Name: "dataset-initializer", Status: jobset.DependencyReady},
+				),
+			},
+			rJobsStatuses: []jobset.ReplicatedJobStatus{
+				{Name: "model-initializer", Succeeded: 1},
+				{Name: "dataset-initializer", Ready: 1},
+			},
+			wantOrder:   []string{"model-initializer", "dataset-initializer", "trainer"},
+			wantReached: []string{"model-initializer", "dataset-initializer"},
+		},
+		{
+			name: "fan-out from a single dependency",
+			rJobs: []jobset.ReplicatedJob{
+				rJob("evaluator", 1, jobset.DependsOn{Name: "initializer", Status: jobset.DependencyComplete}),
+				rJob("trainer", 1, jobset.DependsOn{Name: "initializer", Status: jobset.DependencyComplete}),
+				rJob("initializer", 1),
+			},
+			rJobsStatuses: []jobset.ReplicatedJobStatus{
+				{Name: "initializer", Succeeded: 1},
+			},
+			wantOrder:   []string{"initializer", "evaluator", "trainer"},
+			wantReached: []string{"initializer", "evaluator", "trainer"},
+		},
+		{
+			name: "dependencies are not reached if a transitive dependency is not reached",
+			rJobs: []jobset.ReplicatedJob{
+				rJob("a", 1),
+				rJob("b", 1, jobset.DependsOn{Name: "a", Status: jobset.DependencyComplete}),
+				rJob("c", 1, jobset.DependsOn{Name: "b", Status: jobset.DependencyReady}),
+			},
+			rJobsStatuses: []jobset.ReplicatedJobStatus{
+				{Name: "b", Ready: 1},
+			},
+			wantOrder:   []string{"a", "b", "c"},
+			wantReached: []string{"a"},
+		},
+		{
+			name: "replicated jobs in a cycle are appended at the end and never reached",
+			rJobs: []jobset.ReplicatedJob{
+				rJob("a", 1, jobset.DependsOn{Name: "b", Status: jobset.DependencyReady}),
+				rJob("b", 1, jobset.DependsOn{Name: "a", Status: jobset.DependencyReady}),
+				rJob("c", 1),
+			},
+			wantOrder:   []string{"c", "a", "b"},
+			wantReached: []string{"c"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			jsWrapper := testutils.MakeJobSet(jobSetName, ns)
+			for _, rJob := range tc.rJobs {
+				jsWrapper.ReplicatedJob(rJob)
+			}
+			ordered, reached := evaluateDependencies(jsWrapper.Obj(), tc.rJobsStatuses)
+
+			var gotOrder []string
+			for _, rJob := range ordered {
+				gotOrder = append(gotOrder, rJob.Name)
+			}
+			if diff := cmp.Diff(tc.wantOrder, gotOrder); diff != "" {
+				t.Errorf("unexpected order (-want/+got): %s", diff)
+			}
+			if diff := cmp.Diff(sets.New(tc.wantReached...), reached); diff != "" {
+				t.Errorf("unexpected reached replicated jobs (-want/+got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCleanupReplicatedJobsPending(t *testing.T) {
+	var (
+		jobSetName = "test-jobset"
+		ns         = "default"
+	)
+
+	trainer := testutils.MakeReplicatedJob("trainer").Replicas(2).Obj()
+	uploader := testutils.MakeReplicatedJob("uploader").
+		Replicas(1).
+		DependsOn([]jobset.DependsOn{{Name: "trainer", Status: jobset.DependencyFinished}}).
+		Obj()
+	debugger := testutils.MakeReplicatedJob("debugger").
+		Replicas(1).
+		DependsOn([]jobset.DependsOn{{Name: "trainer", Status: jobset.DependencyFailed}}).
+		Obj()
+
+	tests := []struct {
+		name          string
+		rJobs         []jobset.ReplicatedJob
+		rJobsStatuses []jobset.ReplicatedJobStatus
+		expected      bool
+	}{
+		{
+			name:  "no cleanup replicated jobs",
+			rJobs: []jobset.ReplicatedJob{trainer},
+			rJobsStatuses: []jobset.ReplicatedJobStatus{
+				{Name: "trainer", Failed: 1},
+			},
+			expected: false,
+		},
+		{
+			name:  "cleanup replicated job dependencies are not reached",
+			rJobs: []jobset.ReplicatedJob{trainer, uploader, debugger},
+			rJobsStatuses: []jobset.ReplicatedJobStatus{
+				{Name: "trainer", Succeeded: 1, Active: 1},
+			},
+			expected: false,
+		},
+		{
+			name:  "cleanup replicated job is running after a failure",
+			rJobs: []jobset.ReplicatedJob{trainer, debugger},
+			rJobsStatuses: []jobset.ReplicatedJobStatus{
+				{Name: "trainer", Failed: 1, Active: 1},
+				{Name: "debugger", Active: 1},
+			},
+			expected: true,
+		},
+		{
+			name:  "cleanup replicated job is not created yet after a failure",
+			rJobs: []jobset.ReplicatedJob{trainer, debugger},
+			rJobsStatuses: []jobset.ReplicatedJobStatus{
+				{Name: "trainer", Failed: 1, Active: 1},
+			},
+			expected: true,
+		},
+		{
+			name:  "cleanup replicated job finished after a failure",
+			rJobs: []jobset.ReplicatedJob{trainer, debugger},
+			rJobsStatuses: []jobset.ReplicatedJobStatus{
+				{Name: "trainer", Failed: 1, Active: 1},
+				{Name: "debugger", Succeeded: 1},
+			},
+			expected: false,
+		},
+		{
+			name:  "cleanup replicated job is running after a success",
+			rJobs: []jobset.ReplicatedJob{trainer, uploader, debugger},
+			rJobsStatuses: []jobset.ReplicatedJobStatus{
+				{Name: "trainer", Succeeded: 2},
+				{Name: "uploader", Active: 1},
+			},
+			expected: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			jsWrapper := testutils.MakeJobSet(jobSetName, ns)
+			for _, rJob := range tc.rJobs {
+				jsWrapper.ReplicatedJob(rJob)
+			}
+			actual := cleanupReplicatedJobsPending(jsWrapper.Obj(), tc.rJobsStatuses)
+			if diff := cmp.Diff(tc.expected, actual); diff != "" {
+				t.Errorf("unexpected pending value (-want/+got): %s", diff)
+			}
+		})
+	}
+}
@@ -18,12 +18,16 @@ import (
 	"fmt"
 	"regexp"
 	"slices"
+	"time"
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 	"sigs.k8s.io/jobset/pkg/constants"
@@ -35,6 +39,7 @@ var actionFunctionMap = map[jobset.FailurePolicyAction]failurePolicyActionApplie
 	jobset.FailJobSet:                        failJobSetActionApplier,
 	jobset.RestartJobSet:                     restartJobSetActionApplier,
 	jobset.RestartJobSetAndIgnoreMaxRestarts: restartJobSetAndIgnoreMaxRestartsActionApplier,
+	jobset.SuspendJobSet:                     suspendJobSetActionApplier,
 }
 
 // The source of truth for the definition of defaultFailurePolicyRuleAction is the Configurable Failure Policy KEP.
@@ -42,7 +47,8 @@ const defaultFailurePolicyRuleAction = jobset.RestartJobSet
 
 // executeFailurePolicy applies the Failure Policy of a JobSet when a failed child Job is found.
 // This function is run only when a failed child job has already been found.
-func executeFailurePolicy(ctx context.Context, js *jobset.JobSet, ownedJobs *childJobs, updateStatusOpts *statusUpdateOpts) error {
+// If the restart is delayed by the restart backoff, it returns the time after which the JobSet should be requeued.
+func executeFailurePolicy(ctx context.Context, c client.Client, clock clock.Clock, js *jobset.JobSet, ownedJobs *childJobs, updateStatusOpts *statusUpdateOpts) (time.Duration, error) {
 	log := ctrl.LoggerFrom(ctx)
 
 	// If no failure policy is defined, mark the JobSet as failed.
@@ -53,13 +59,22 @@ func executeFailurePolicy(ctx context.Context, js *jobset.JobSet, ownedJobs *chi
 		// possible code paths here.
 		firstFailedJob := findFirstFailedJob(ownedJobs.failed)
 		msg := messageWithFirstFailedJob(constants.FailedJobsMessage, firstFailedJob.Name)
-		setJobSetFailedCondition(js, constants.FailedJobsReason, msg, updateStatusOpts)
-		return nil
+		setJobSetFailedCondition(clock, js, constants.FailedJobsReason, msg, updateStatusOpts)
+		return 0, nil
 	}
 
 	// Check for matching Failure Policy Rule
 	rules := js.Spec.FailurePolicy.Rules
-	matchingFailurePolicyRule, matchingFailedJob := findFirstFailedPolicyRuleAndJob(ctx, rules, ownedJobs.failed)
+	// The failed pods of the failed jobs are only listed if a rule has requirements on them.
+	var failedPods map[string][]corev1.Pod
+	if slices.ContainsFunc(rules, ruleHasPodRequirements) {
+		var err error
+		if failedPods, err = listFailedPods(ctx, c, ownedJobs.failed); err != nil {
+			log.Error(err, "listing failed pods of failed jobs")
+			return 0, err
+		}
+	}
+	matchingFailurePolicyRule, matchingFailedJob := findFirstFailedPolicyRuleAndJob(ctx, rules, ownedJobs.failed, failedPods)
 
 	var failurePolicyRuleAction jobset.FailurePolicyAction
 	if matchingFailurePolicyRule == nil {
@@ -69,18 +84,24 @@ func executeFailurePolicy(ctx context.Context, js *jobset.JobSet, ownedJobs *chi
 		failurePolicyRuleAction = matchingFailurePolicyRule.Action
 	}
 
-	if err := applyFailurePolicyRuleAction(ctx, js, matchingFailedJob, updateStatusOpts, failurePolicyRuleAction); err != nil {
+	// Delay the restart if the failure policy has a restart backoff.
+	if requeueAfter := executeRestartBackoff(ctx, clock, js, matchingFailurePolicyRule, matchingFailedJob, failurePolicyRuleAction, updateStatusOpts); requeueAfter > 0 {
+		return requeueAfter, nil
+	}
+
+	if err := applyFailurePolicyRuleAction(ctx, clock, js, matchingFailurePolicyRule, matchingFailedJob, updateStatusOpts, failurePolicyRuleAction); err != nil {
 		log.Error(err, "applying FailurePolicyRuleAction %v", failurePolicyRuleAction)
-		return err
+		return 0, err
 	}
 
-	return nil
+	return 0, nil
 }
 
 // findFirstFailedPolicyRuleAndJob returns the first failure policy rule matching a failed child job.
 // The function also returns the first child job matching the failure policy rule returned.
 // If there does not exist a matching failure policy rule, then the function returns nil for all values.
-func findFirstFailedPolicyRuleAndJob(ctx context.Context, rules []jobset.FailurePolicyRule, failedOwnedJobs []*batchv1.Job) (*jobset.FailurePolicyRule, *batchv1.Job) {
+// The failed pods of each failed job are looked up by job name in failedPods.
+func findFirstFailedPolicyRuleAndJob(ctx context.Context, rules []jobset.FailurePolicyRule, failedOwnedJobs []*batchv1.Job, failedPods map[string][]corev1.Pod) (*jobset.FailurePolicyRule, *batchv1.Job) {
 	log := ctrl.LoggerFrom(ctx)
 
 	for index, rule := range rules {
@@ -97,7 +118,7 @@ func findFirstFailedPolicyRuleAndJob(ctx context.Context, rules []jobset.Failure
 			jobFailureReason := jobFailureCondition.Reason
 			jobFailureMessage := jobFailureCondition.Message
 			jobFailedEarlier := matchedFailedJob == nil || jobFailureTime.Before(matchedFailureTime)
-			if ruleIsApplicable(ctx, rule, failedJob, jobFailureReason, jobFailureMessage) && jobFailedEarlier {
+			if ruleIsApplicable(ctx, rule, failedJob, jobFailureReason, jobFailureMessage, failedPods[failedJob.Name]) && jobFailedEarlier {
 				matchedFailedJob = failedJob
 				matchedFailureTime = jobFailureTime
 			}
@@ -115,7 +136,8 @@ func findFirstFailedPolicyRuleAndJob(ctx context.Context, rules []jobset.Failure
 }
 
 // applyFailurePolicyRuleAction applies the supplied FailurePolicyRuleAction.
-func applyFailurePolicyRuleAction(ctx context.Context, js *jobset.JobSet, matchingFailedJob *batchv1.Job, updateStatusOps *statusUpdateOpts, failurePolicyRuleAction jobset.FailurePolicyAction) error {
+// The matching failure policy rule is nil if the default action is applied.
+func applyFailurePolicyRuleAction(ctx context.Context, clock clock.Clock, js *jobset.JobSet, matchingRule *jobset.FailurePolicyRule, matchingFailedJob *batchv1.Job, updateStatusOps *statusUpdateOpts, failurePolicyRuleAction jobset.FailurePolicyAction) error {
 	log := ctrl.LoggerFrom(ctx)
 
 	applier, ok := actionFunctionMap[failurePolicyRuleAction]
@@ -125,7 +147,7 @@ func applyFailurePolicyRuleAction(ctx context.Context, js *jobset.JobSet, matchi
 		return err
 	}
 
-	if err := applier(ctx, js, matchingFailedJob, updateStatusOps); err != nil {
+	if err := applier(ctx, clock, js, matchingRule, matchingFailedJob, updateStatusOps); err != nil {
 		log.Error(err, "error applying the FailurePolicyRuleAction: %v", failurePolicyRuleAction)
 		return err
 	}
@@ -133,9 +155,9 @@ func applyFailurePolicyRuleAction(ctx context.Context, js *jobset.JobSet, matchi
 	return nil
 }
 
-// ruleIsApplicable returns true if the failed job, job failure reason and job failure message match the failure policy rule.
-// The function returns false otherwise.
-func ruleIsApplicable(ctx context.Context, rule jobset.FailurePolicyRule, failedJob *batchv1.Job, jobFailureReason string, jobFailureMessage string) bool {
+// ruleIsApplicable returns true if the failed job, job failure reason, job failure message and failed pods of the job
+// match the failure policy rule. The function returns false otherwise.
+func ruleIsApplicable(ctx context.Context, rule jobset.FailurePolicyRule, failedJob *batchv1.Job, jobFailureReason string, jobFailureMessage string, failedPods []corev1.Pod) bool {
 	log := ctrl.LoggerFrom(ctx)
 
 	ruleAppliesToJobFailureReason := len(rule.OnJobFailureReasons) == 0 || slices.Contains(rule.OnJobFailureReasons, jobFailureReason)
@@ -148,6 +170,20 @@ func ruleIsApplicable(ctx context.Context, rule jobset.FailurePolicyRule, failed
 		return false
 	}
 
+	ruleAppliesToPodExitCodes := rule.OnPodExitCodes == nil || slices.ContainsFunc(failedPods, func(pod corev1.Pod) bool {
+		return podExitCodesMatch(rule.OnPodExitCodes, &pod)
+	})
+	if !ruleAppliesToPodExitCodes {
+		return false
+	}
+
+	ruleAppliesToPodConditions := len(rule.OnPodConditions) == 0 || slices.ContainsFunc(failedPods, func(pod corev1.Pod) bool {
+		return podConditionsMatch(rule.OnPodConditions, &pod)
+	})
+	if !ruleAppliesToPodConditions {
+		return false
+	}
+
 	parentReplicatedJob, exists := parentReplicatedJobName(failedJob)
 	if !exists {
 		// If we cannot find the parent ReplicatedJob, we assume the rule does not apply.
@@ -178,8 +214,70 @@ func anyMatchFound(ctx context.Context, patterns []string, message string) bool
 	return false
 }
 
+// ruleHasPodRequirements returns true if the failure policy rule has requirements on the failed pods of a job.
+func ruleHasPodRequirements(rule jobset.FailurePolicyRule) bool {
+	return rule.OnPodExitCodes != nil || len(rule.OnPodConditions) > 0
+}
+
+// listFailedPods returns the failed pods of each of the given jobs, keyed by job name.
+func listFailedPods(ctx context.Context, c client.Client, jobs []*batchv1.Job) (map[string][]corev1.Pod, error) {
+	failedPods := make(map[string][]corev1.Pod, len(jobs))
+	for _, job := range jobs {
+		var podList corev1.PodList
+		if err := c.List(ctx, &podList, client.InNamespace(job.Namespace), client.MatchingLabels{batchv1.JobNameLabel: job.Name}); err != nil {
+			return nil, err
+		}
+		for _, pod := range podList.Items {
+			// Skip pods of a previous job with the same name.
+			if !metav1.IsControlledBy(&pod, job) || pod.Status.Phase != corev1.PodFailed {
+				continue
+			}
+			failedPods[job.Name] = append(failedPods[job.Name], pod)
+		}
+	}
+	return failedPods, nil
+}
+
+// podExitCodesMatch returns true if a container of the pod terminated with a non-zero exit code
+// matching the exit codes requirement. The function returns false otherwise.
+func podExitCodesMatch(requirement *batchv1.PodFailurePolicyOnExitCodesRequirement, pod *corev1.Pod) bool {
+	for _, containerStatus := range slices.Concat(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses) {
+		if requirement.ContainerName != nil && *requirement.ContainerName != containerStatus.Name {
+			continue
+		}
+		if containerStatus.State.Terminated == nil || containerStatus.State.Terminated.ExitCode == 0 {
+			continue
+		}
+		exitCodeInValues := slices.Contains(requirement.Values, containerStatus.State.Terminated.ExitCode)
+		switch requirement.Operator {
+		case batchv1.PodFailurePolicyOnExitCodesOpIn:
+			if exitCodeInValues {
+				return true
+			}
+		case batchv1.PodFailurePolicyOnExitCodesOpNotIn:
+			if !exitCodeInValues {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// podConditionsMatch returns true if the pod has a condition matching at least one of the patterns.
+// The function returns false otherwise.
+func podConditionsMatch(patterns []batchv1.PodFailurePolicyOnPodConditionsPattern, pod *corev1.Pod) bool {
+	for _, pattern := range patterns {
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == pattern.Type && condition.Status == pattern.Status {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // failurePolicyRecreateAll triggers a JobSet restart for the next reconcillation loop.
-func failurePolicyRecreateAll(ctx context.Context, js *jobset.JobSet, shouldCountTowardsMax bool, updateStatusOpts *statusUpdateOpts, event *eventParams) {
+func failurePolicyRecreateAll(ctx context.Context, clock clock.Clock, js *jobset.JobSet, rule *jobset.FailurePolicyRule, failedJob *batchv1.Job, shouldCountTowardsMax bool, updateStatusOpts *statusUpdateOpts, event *eventParams) {
 	log := ctrl.LoggerFrom(ctx)
 
 	if updateStatusOpts == nil {
@@ -188,11 +286,9 @@ func failurePolicyRecreateAll(ctx context.Context, js *jobset.JobSet, shouldCoun
 
 	// Increment JobSet restarts. This will trigger reconciliation and result in deletions
 	// of old jobs not part of the current jobSet run.
-	js.Status.Restarts += 1
-
-	if shouldCountTowardsMax {
-		js.Status.RestartsCountTowardsMax += 1
-	}
+	incrementRestarts(clock, js, rule, failedJob, shouldCountTowardsMax)
+	// Recreating all the child jobs starts a new attempt of the JobSet.
+	js.Status.CurrentAttemptStartTime = ptr.To(metav1.NewTime(clock.Now()))
 
 	updateStatusOpts.shouldUpdate = true
 
@@ -201,28 +297,187 @@ func failurePolicyRecreateAll(ctx context.Context, js *jobset.JobSet, shouldCoun
 	log.V(2).Info("attempting restart", "restart attempt", js.Status.Restarts)
 }
 
+// failurePolicyRecreateFailedJob triggers the recreation of the failed child job for the next reconcillation loop,
+// leaving the other child jobs running.
+func failurePolicyRecreateFailedJob(ctx context.Context, clock clock.Clock, js *jobset.JobSet, rule *jobset.FailurePolicyRule, failedJob *batchv1.Job, shouldCountTowardsMax bool, updateStatusOpts *statusUpdateOpts, event *eventParams) {
+	log := ctrl.LoggerFrom(ctx)
+
+	if updateStatusOpts == nil {
+		updateStatusOpts = &statusUpdateOpts{}
+	}
+
+	// Increment the restarts of the failed job. This will trigger reconciliation and result in
+	// the deletion of the failed job, which is then recreated at the same index.
+	jobRestarts := jobRestartAttempt(js, failedJob.Name) + 1
+	setJobRestartAttempt(js, failedJob.Name, jobRestarts)
+
+	// Every recreated job counts as a restart of the JobSet.
+	incrementRestarts(clock, js, rule, failedJob, shouldCountTowardsMax)
+
+	updateStatusOpts.shouldUpdate = true
+
+	// Emit event for each job recreation for observability and debugability.
+	enqueueEvent(updateStatusOpts, event)
+	log.V(2).Info("attempting job recreation", "job", failedJob.Name, "restart attempt", jobRestarts)
+}
+
+// failurePolicyRestartInPlace bumps the in-place restart attempt of the JobSet, which restarts its healthy
+// pods in-place, and triggers the recreation of the failed child job, which does not create new pods once failed.
+func failurePolicyRestartInPlace(ctx context.Context, clock clock.Clock, js *jobset.JobSet, rule *jobset.FailurePolicyRule, failedJob *batchv1.Job, shouldCountTowardsMax bool, updateStatusOpts *statusUpdateOpts, event *eventParams) {
+	log := ctrl.LoggerFrom(ctx)
+
+	bumpInPlaceRestartAttempt(js)
+	log.V(2).Info("attempting in-place restart", "previous in-place restart attempt", *js.Status.PreviousInPlaceRestartAttempt)
+	failurePolicyRecreateFailedJob(ctx, clock, js, rule, failedJob, shouldCountTowardsMax, updateStatusOpts, event)
+}
+
+// failurePolicyRestart triggers a restart according to the restart strategy of the JobSet,
+// and records it in the restart history of the JobSet.
+func failurePolicyRestart(ctx context.Context, clock clock.Clock, js *jobset.JobSet, rule *jobset.FailurePolicyRule, failedJob *batchv1.Job, action jobset.FailurePolicyAction, shouldCountTowardsMax bool, updateStatusOpts *statusUpdateOpts, event *eventParams) {
+	switch {
+	case inPlaceRestartEnabled(js):
+		failurePolicyRestartInPlace(ctx, clock, js, rule, failedJob, shouldCountTowardsMax, updateStatusOpts, event)
+	case recreateFailedJobsEnabled(js):
+		failurePolicyRecreateFailedJob(ctx, clock, js, rule, failedJob, shouldCountTowardsMax, updateStatusOpts, event)
+	default:
+		failurePolicyRecreateAll(ctx, clock, js, rule, failedJob, shouldCountTowardsMax, updateStatusOpts, event)
+	}
+	recordRestart(clock, js, rule, failedJob, action)
+}
+
+// maxRestartHistory is the maximum number of restarts kept in the restart history of the JobSet.
+const maxRestartHistory = 10
+
+// recordRestart appends the last restart of the JobSet to its restart history,
+// dropping the oldest restarts beyond maxRestartHistory.
+// When only the failed job is recreated, see jobRestartsEnabled, the attempt recorded is the restart
+// attempt of the recreated job rather than the restart attempt of the JobSet.
+func recordRestart(clock clock.Clock, js *jobset.JobSet, rule *jobset.FailurePolicyRule, failedJob *batchv1.Job, action jobset.FailurePolicyAction) {
+	entry := jobset.RestartHistoryEntry{
+		Attempt: js.Status.Restarts,
+		Time:    metav1.NewTime(clock.Now()),
+		Action:  action,
+	}
+	if jobRestartsEnabled(js) && failedJob != nil {
+		entry.Attempt = jobRestartAttempt(js, failedJob.Name)
+	}
+	if rule != nil {
+		entry.FailurePolicyRule = rule.Name
+	}
+	if failedJob != nil {
+		entry.FailedJob = failedJob.Name
+		if jobFailureCondition := findJobFailureCondition(failedJob); jobFailureCondition != nil {
+			entry.Reason = jobFailureCondition.Reason
+			entry.Message = jobFailureCondition.Message
+		}
+	}
+	history := append(js.Status.RestartHistory, entry)
+	if len(history) > maxRestartHistory {
+		history = history[len(history)-maxRestartHistory:]
+	}
+	js.Status.RestartHistory = history
+}
+
+// incrementRestarts increments the restart counters of the JobSet and of the ReplicatedJob of the failed job.
+// A restart counting towards max restarts counts either towards the restart limit of the ReplicatedJob,
+// if it has one for the failed job, or towards the maxRestarts of the JobSet, never both.
+func incrementRestarts(clock clock.Clock, js *jobset.JobSet, rule *jobset.FailurePolicyRule, failedJob *batchv1.Job, shouldCountTowardsMax bool) {
+	js.Status.Restarts += 1
+
+	_, hasReplicatedJobMaxRestarts := replicatedJobMaxRestarts(js, rule, failedJob)
+	if shouldCountTowardsMax && !hasReplicatedJobMaxRestarts {
+		js.Status.RestartsCountTowardsMax += 1
+		if restartTimesEnabled(js) {
+			js.Status.RestartTimes = append(js.Status.RestartTimes, metav1.NewTime(clock.Now()))
+		}
+	}
+
+	replicatedJobName, ok := parentReplicatedJobName(failedJob)
+	if !ok {
+		return
+	}
+	status := replicatedJobStatusForRestarts(js, replicatedJobName)
+	status.Restarts += 1
+	if shouldCountTowardsMax && hasReplicatedJobMaxRestarts {
+		status.RestartsCountTowardsMax += 1
+		if restartTimesEnabled(js) {
+			status.RestartTimes = append(status.RestartTimes, metav1.NewTime(clock.Now()))
+		}
+	}
+}
+
+// replicatedJobStatusForRestarts returns a pointer to the status of the ReplicatedJob with the given name
+// in the JobSet status, adding it if it does not exist yet.
+func replicatedJobStatusForRestarts(js *jobset.JobSet, replicatedJobName string) *jobset.ReplicatedJobStatus {
+	for i := range js.Status.ReplicatedJobsStatus {
+		if js.Status.ReplicatedJobsStatus[i].Name == replicatedJobName {
+			return &js.Status.ReplicatedJobsStatus[i]
+		}
+	}
+	js.Status.ReplicatedJobsStatus = append(js.Status.ReplicatedJobsStatus, jobset.ReplicatedJobStatus{Name: replicatedJobName})
+	return &js.Status.ReplicatedJobsStatus[len(js.Status.ReplicatedJobsStatus)-1]
+}
+
+// recreateFailedJobsEnabled returns true if the JobSet is using the RecreateFailedJobs restart strategy.
+func recreateFailedJobsEnabled(js *jobset.JobSet) bool {
+	return js.Spec.FailurePolicy != nil && js.Spec.FailurePolicy.RestartStrategy == jobset.RecreateFailedJobs
+}
+
+// jobRestartsEnabled returns true if a failed child job is recreated alone, so its restarts are
+// counted per job, i.e. with the RecreateFailedJobs or the InPlaceRestart restart strategy.
+func jobRestartsEnabled(js *jobset.JobSet) bool {
+	return recreateFailedJobsEnabled(js) || inPlaceRestartEnabled(js)
+}
+
+// jobRestartAttempt returns the restart attempt the child job with the given name should be at.
+// When the restarts are counted per job, see jobRestartsEnabled, each child job is at its own
+// restart attempt. Otherwise, all child jobs are at the restart attempt of the JobSet.
+func jobRestartAttempt(js *jobset.JobSet, jobName string) int32 {
+	if !jobRestartsEnabled(js) {
+		return js.Status.Restarts
+	}
+	for _, jobRestarts := range js.Status.JobRestarts {
+		if jobRestarts.Name == jobName {
+			return jobRestarts.Restarts
+		}
+	}
+	return 0
+}
+
+// setJobRestartAttempt sets the restart attempt of the child job with the given name in the JobSet status.
+func setJobRestartAttempt(js *jobset.JobSet, jobName string, restarts int32) {
+	for i := range js.Status.JobRestarts {
+		if js.Status.JobRestarts[i].Name == jobName {
+			js.Status.JobRestarts[i].Restarts = restarts
+			return
+		}
+	}
+	js.Status.JobRestarts = append(js.Status.JobRestarts, jobset.JobRestartStatus{Name: jobName, Restarts: restarts})
+}
+
 // The type failurePolicyActionApplier applies a FailurePolicyAction and returns nil if the FailurePolicyAction was successfully applied.
 // The function returns an error otherwise.
-type failurePolicyActionApplier = func(ctx context.Context, js *jobset.JobSet, matchingFailedJob *batchv1.Job, updateStatusOpts *statusUpdateOpts) error
+// The matching failure policy rule is nil if the default action is applied.
+type failurePolicyActionApplier = func(ctx context.Context, clock clock.Clock, js *jobset.JobSet, matchingRule *jobset.FailurePolicyRule, matchingFailedJob *batchv1.Job, updateStatusOpts *statusUpdateOpts) error
 
 // failJobSetActionApplier applies the FailJobSet FailurePolicyAction
-var failJobSetActionApplier failurePolicyActionApplier = func(ctx context.Context, js *jobset.JobSet, matchingFailedJob *batchv1.Job, updateStatusOpts *statusUpdateOpts) error {
+var failJobSetActionApplier failurePolicyActionApplier = func(ctx context.Context, clock clock.Clock, js *jobset.JobSet, matchingRule *jobset.FailurePolicyRule, matchingFailedJob *batchv1.Job, updateStatusOpts *statusUpdateOpts) error {
 	failureBaseMessage := constants.FailJobSetActionMessage
 	failureMessage := messageWithFirstFailedJob(failureBaseMessage, matchingFailedJob.Name)
 
 	failureReason := constants.FailJobSetActionReason
-	setJobSetFailedCondition(js, failureReason, failureMessage, updateStatusOpts)
+	setJobSetFailedCondition(clock, js, failureReason, failureMessage, updateStatusOpts)
 	return nil
 }
 
 // restartJobSetActionApplier applies the RestartJobSet FailurePolicyAction
-var restartJobSetActionApplier failurePolicyActionApplier = func(ctx context.Context, js *jobset.JobSet, matchingFailedJob *batchv1.Job, updateStatusOpts *statusUpdateOpts) error {
-	if js.Status.RestartsCountTowardsMax >= js.Spec.FailurePolicy.MaxRestarts {
+var restartJobSetActionApplier failurePolicyActionApplier = func(ctx context.Context, clock clock.Clock, js *jobset.JobSet, matchingRule *jobset.FailurePolicyRule, matchingFailedJob *batchv1.Job, updateStatusOpts *statusUpdateOpts) error {
+	if reachedMaxRestarts(js, matchingRule, matchingFailedJob) {
 		failureBaseMessage := constants.ReachedMaxRestartsMessage
 		failureMessage := messageWithFirstFailedJob(failureBaseMessage, matchingFailedJob.Name)
 
 		failureReason := constants.ReachedMaxRestartsReason
-		setJobSetFailedCondition(js, failureReason, failureMessage, updateStatusOpts)
+		setJobSetFailedCondition(clock, js, failureReason, failureMessage, updateStatusOpts)
 		return nil
 	}
 
@@ -236,12 +491,46 @@ var restartJobSetActionApplier failurePolicyActionApplier = func(ctx context.Con
 	}
 
 	shouldCountTowardsMax := true
-	failurePolicyRecreateAll(ctx, js, shouldCountTowardsMax, updateStatusOpts, event)
+	failurePolicyRestart(ctx, clock, js, matchingRule, matchingFailedJob, jobset.RestartJobSet, shouldCountTowardsMax, updateStatusOpts, event)
 	return nil
 }
 
+// reachedMaxRestarts returns true if the JobSet has exhausted the restarts allowed by its failure policy
+// for the failed job. If the ReplicatedJob of the failed job has its own restart limit, the restarts
+// of the ReplicatedJob are checked against it. Otherwise, the restarts of the JobSet are checked against maxRestarts.
+func reachedMaxRestarts(js *jobset.JobSet, rule *jobset.FailurePolicyRule, failedJob *batchv1.Job) bool {
+	if maxRestarts, ok := replicatedJobMaxRestarts(js, rule, failedJob); ok {
+		replicatedJobName, _ := parentReplicatedJobName(failedJob)
+		var restarts int32
+		if status := findReplicatedJobStatus(js.Status.ReplicatedJobsStatus, replicatedJobName); status != nil {
+			restarts = status.RestartsCountTowardsMax
+		}
+		return restarts >= maxRestarts
+	}
+	return js.Status.RestartsCountTowardsMax >= js.Spec.FailurePolicy.MaxRestarts
+}
+
+// replicatedJobMaxRestarts returns the restart limit of the ReplicatedJob of the failed job and true,
+// if the matching failure policy rule or the replicatedJobMaxRestarts of the failure policy sets one.
+// The maxRestarts of the rule takes precedence. The function returns false otherwise.
+func replicatedJobMaxRestarts(js *jobset.JobSet, rule *jobset.FailurePolicyRule, failedJob *batchv1.Job) (int32, bool) {
+	replicatedJobName, ok := parentReplicatedJobName(failedJob)
+	if !ok || js.Spec.FailurePolicy == nil {
+		return 0, false
+	}
+	if rule != nil && rule.MaxRestarts != nil {
+		return *rule.MaxRestarts, true
+	}
+	for _, rjMaxRestarts := range js.Spec.FailurePolicy.ReplicatedJobMaxRestarts {
+		if rjMaxRestarts.Name == replicatedJobName {
+			return rjMaxRestarts.MaxRestarts, true
+		}
+	}
+	return 0, false
+}
+
 // restartJobSetAndIgnoreMaxRestartsActionApplier applies the RestartJobSetAndIgnoreMaxRestarts FailurePolicyAction
-var restartJobSetAndIgnoreMaxRestartsActionApplier failurePolicyActionApplier = func(ctx context.Context, js *jobset.JobSet, matchingFailedJob *batchv1.Job, updateStatusOpts *statusUpdateOpts) error {
+var restartJobSetAndIgnoreMaxRestartsActionApplier failurePolicyActionApplier = func(ctx context.Context, clock clock.Clock, js *jobset.JobSet, matchingRule *jobset.FailurePolicyRule, matchingFailedJob *batchv1.Job, updateStatusOpts *statusUpdateOpts) error {
 	baseMessage := constants.RestartJobSetAndIgnoreMaxRestartsActionMessage
 	eventMessage := messageWithFirstFailedJob(baseMessage, matchingFailedJob.Name)
 	event := &eventParams{
@@ -252,10 +541,75 @@ var restartJobSetAndIgnoreMaxRestartsActionApplier failurePolicyActionApplier =
 	}
 
 	shouldCountTowardsMax := false
-	failurePolicyRecreateAll(ctx, js, shouldCountTowardsMax, updateStatusOpts, event)
+	failurePolicyRestart(ctx, clock, js, matchingRule, matchingFailedJob, jobset.RestartJobSetAndIgnoreMaxRestarts, shouldCountTowardsMax, updateStatusOpts, event)
+	return nil
+}
+
+// suspendJobSetActionApplier applies the SuspendJobSet FailurePolicyAction
+var suspendJobSetActionApplier failurePolicyActionApplier = func(ctx context.Context, clock clock.Clock, js *jobset.JobSet, matchingRule *jobset.FailurePolicyRule, matchingFailedJob *batchv1.Job, updateStatusOpts *statusUpdateOpts) error {
+	// If the JobSet held by this action has been resumed, restart it without counting
+	// the failure towards max restarts, since the restart was approved by the operator.
+	if jobSetHeld(js) && !jobSetSuspended(js) {
+		setCondition(js, makeReleasedConditionOpts(), updateStatusOpts)
+
+		baseMessage := constants.ResumedAfterSuspendJobSetActionMessage
+		eventMessage := messageWithFirstFailedJob(baseMessage, matchingFailedJob.Name)
+		event := &eventParams{
+			object:       js,
+			eventType:    corev1.EventTypeNormal,
+			eventReason:  constants.JobSetRestartReason,
+			eventMessage: eventMessage,
+		}
+
+		shouldCountTowardsMax := false
+		failurePolicyRestart(ctx, clock, js, matchingRule, matchingFailedJob, jobset.SuspendJobSet, shouldCountTowardsMax, updateStatusOpts, event)
+		return nil
+	}
+
+	if updateStatusOpts == nil {
+		updateStatusOpts = &statusUpdateOpts{}
+	}
+	if !jobSetSuspended(js) {
+		updateStatusOpts.shouldSuspend = true
+	}
+	baseMessage := constants.SuspendJobSetActionMessage
+	message := messageWithFirstFailedJob(baseMessage, matchingFailedJob.Name)
+	setCondition(js, makeHeldConditionOpts(message), updateStatusOpts)
 	return nil
 }
 
+// jobSetHeld returns true if the JobSet has been held by the SuspendJobSet failure policy action.
+func jobSetHeld(js *jobset.JobSet) bool {
+	return meta.IsStatusConditionTrue(js.Status.Conditions, string(jobset.JobSetHeld))
+}
+
+// makeHeldConditionOpts returns the options we use to generate the JobSet held condition.
+func makeHeldConditionOpts(msg string) *conditionOpts {
+	return &conditionOpts{
+		condition: &metav1.Condition{
+			Type:    string(jobset.JobSetHeld),
+			Status:  metav1.ConditionTrue,
+			Reason:  constants.SuspendJobSetActionReason,
+			Message: msg,
+		},
+		eventType: corev1.EventTypeWarning,
+	}
+}
+
+// makeReleasedConditionOpts returns the options we use to update the JobSet held condition
+// from "true" to "false" once the JobSet is resumed.
+func makeReleasedConditionOpts() *conditionOpts {
+	return &conditionOpts{
+		condition: &metav1.Condition{
+			Type:    string(jobset.JobSetHeld),
+			Status:  metav1.ConditionFalse,
+			Reason:  constants.ResumedAfterSuspendJobSetActionReason,
+			Message: constants.ResumedAfterSuspendJobSetActionMessage,
+		},
+		eventType: corev1.EventTypeNormal,
+	}
+}
+
 // parentReplicatedJobName returns the name of the parent
 // ReplicatedJob and true if it is able to retrieve the parent.
 // The empty string and false are returned otherwise.
@@ -283,9 +637,10 @@ func makeFailedConditionOpts(reason, msg string) *conditionOpts {
 }
 
 // setJobSetFailedCondition sets a condition and terminal state on the JobSet status indicating it has failed.
-func setJobSetFailedCondition(js *jobset.JobSet, reason, msg string, updateStatusOpts *statusUpdateOpts) {
+func setJobSetFailedCondition(clock clock.Clock, js *jobset.JobSet, reason, msg string, updateStatusOpts *statusUpdateOpts) {
 	setCondition(js, makeFailedConditionOpts(reason, msg), updateStatusOpts)
 	js.Status.TerminalState = string(jobset.JobSetFailed)
+	setCompletionTime(clock, js)
 	// Update the metrics
 	metrics.JobSetFailed(js.Name, js.Namespace)
 }
@@ -15,6 +15,7 @@ package controllers
 
 import (
 	"context"
+	"slices"
 	"testing"
 	"time"
 
@@ -22,7 +23,9 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/stretchr/testify/assert"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 
 	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
@@ -97,6 +100,7 @@ func TestFailurePolicyRuleIsApplicable(t *testing.T) {
 		failedJob         *batchv1.Job
 		jobFailureReason  string
 		jobFailureMessage string
+		failedPods        []corev1.Pod
 		expected          bool
 	}{
 		{
@@ -255,11 +259,118 @@ func TestFailurePolicyRuleIsApplicable(t *testing.T) {
 			jobFailureMessage: "job failed due to something else",
 			expected:          false,
 		},
+		{
+			name: "a job has failed and the failure policy rule matches the exit code of a failed pod",
+			rule: jobset.FailurePolicyRule{
+				OnPodExitCodes: &batchv1.PodFailurePolicyOnExitCodesRequirement{
+					Operator: batchv1.PodFailurePolicyOnExitCodesOpIn,
+					Values:   []int32{42},
+				},
+			},
+			failedJob: testutils.MakeJob(jobName, ns).JobLabels(
+				map[string]string{jobset.ReplicatedJobNameKey: replicatedJobName1},
+			).Obj(),
+			jobFailureReason: jobFailureReason1,
+			failedPods:       []corev1.Pod{failedPodWithExitCode("pod-0", "main", 1), failedPodWithExitCode("pod-1", "main", 42)},
+			expected:         true,
+		},
+		{
+			name: "a job has failed and the failure policy rule does not match the exit code of any failed pod",
+			rule: jobset.FailurePolicyRule{
+				OnPodExitCodes: &batchv1.PodFailurePolicyOnExitCodesRequirement{
+					Operator: batchv1.PodFailurePolicyOnExitCodesOpIn,
+					Values:   []int32{42},
+				},
+			},
+			failedJob: testutils.MakeJob(jobName, ns).JobLabels(
+				map[string]string{jobset.ReplicatedJobNameKey: replicatedJobName1},
+			).Obj(),
+			jobFailureReason: jobFailureReason1,
+			failedPods:       []corev1.Pod{failedPodWithExitCode("pod-0", "main", 1)},
+			expected:         false,
+		},
+		{
+			name: "a job has failed and the failure policy rule matches an exit code not in the values",
+			rule: jobset.FailurePolicyRule{
+				OnPodExitCodes: &batchv1.PodFailurePolicyOnExitCodesRequirement{
+					Operator: batchv1.PodFailurePolicyOnExitCodesOpNotIn,
+					Values:   []int32{42},
+				},
+			},
+			failedJob: testutils.MakeJob(jobName, ns).JobLabels(
+				map[string]string{jobset.ReplicatedJobNameKey: replicatedJobName1},
+			).Obj(),
+			jobFailureReason: jobFailureReason1,
+			failedPods:       []corev1.Pod{failedPodWithExitCode("pod-0", "main", 1)},
+			expected:         true,
+		},
+		{
+			name: "a job has failed and the failure policy rule only matches the exit code of another container",
+			rule: jobset.FailurePolicyRule{
+				OnPodExitCodes: &batchv1.PodFailurePolicyOnExitCodesRequirement{
+					ContainerName: ptr.To("sidecar"),
+					Operator:      batchv1.PodFailurePolicyOnExitCodesOpIn,
+					Values:        []int32{42},
+				},
+			},
+			failedJob: testutils.MakeJob(jobName, ns).JobLabels(
+				map[string]string{jobset.ReplicatedJobNameKey: replicatedJobName1},
+			).Obj(),
+			jobFailureReason: jobFailureReason1,
+			failedPods:       []corev1.Pod{failedPodWithExitCode("pod-0", "main", 42)},
+			expected:         false,
+		},
+		{
+			name: "a job has failed and the failure policy rule matches the condition of a failed pod",
+			rule: jobset.FailurePolicyRule{
+				OnPodConditions: []batchv1.PodFailurePolicyOnPodConditionsPattern{
+					{Type: corev1.DisruptionTarget, Status: corev1.ConditionTrue},
+				},
+			},
+			failedJob: testutils.MakeJob(jobName, ns).JobLabels(
+				map[string]string{jobset.ReplicatedJobNameKey: replicatedJobName1},
+			).Obj(),
+			jobFailureReason: jobFailureReason1,
+			failedPods: []corev1.Pod{
+				testutils.MakePod("pod-0", ns).SetConditions([]corev1.PodCondition{
+					{Type: corev1.DisruptionTarget, Status: corev1.ConditionTrue},
+				}).Obj(),
+			},
+			expected: true,
+		},
+		{
+			name: "a job has failed and the failure policy rule does not match the conditions of any failed pod",
+			rule: jobset.FailurePolicyRule{
+				OnPodConditions: []batchv1.PodFailurePolicyOnPodConditionsPattern{
+					{Type: corev1.DisruptionTarget, Status: corev1.ConditionTrue},
+				},
+			},
+			failedJob: testutils.MakeJob(jobName, ns).JobLabels(
+				map[string]string{jobset.ReplicatedJobNameKey: replicatedJobName1},
+			).Obj(),
+			jobFailureReason: jobFailureReason1,
+			failedPods:       []corev1.Pod{failedPodWithExitCode("pod-0", "main", 1)},
+			expected:         false,
+		},
+		{
+			name: "a job has failed without failed pods and the failure policy rule has pod requirements",
+			rule: jobset.FailurePolicyRule{
+				OnPodExitCodes: &batchv1.PodFailurePolicyOnExitCodesRequirement{
+					Operator: batchv1.PodFailurePolicyOnExitCodesOpNotIn,
+					Values:   []int32{42},
+				},
+			},
+			failedJob: testutils.MakeJob(jobName, ns).JobLabels(
+				map[string]string{jobset.ReplicatedJobNameKey: replicatedJobName1},
+			).Obj(),
+			jobFailureReason: jobFailureReason1,
+			expected:         false,
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			actual := ruleIsApplicable(context.TODO(), tc.rule, tc.failedJob, tc.jobFailureReason, tc.jobFailureMessage)
+			actual := ruleIsApplicable(context.TODO(), tc.rule, tc.failedJob, tc.jobFailureReason, tc.jobFailureMessage, tc.failedPods)
 			if diff := cmp.Diff(tc.expected, actual); diff != "" {
 				t.Errorf("unexpected finished value (+got/-want): %s", diff)
 			}
@@ -319,11 +430,20 @@ func TestFindFirstFailedPolicyRuleAndJob(t *testing.T) {
 			Action:               jobset.RestartJobSet,
 			TargetReplicatedJobs: []string{fakeReplicatedJobName},
 		}
+
+		exitCodeFailurePolicyRule = jobset.FailurePolicyRule{
+			Action: jobset.RestartJobSet,
+			OnPodExitCodes: &batchv1.PodFailurePolicyOnExitCodesRequirement{
+				Operator: batchv1.PodFailurePolicyOnExitCodesOpIn,
+				Values:   []int32{42},
+			},
+		}
 	)
 	tests := []struct {
 		name            string
 		rules           []jobset.FailurePolicyRule
 		failedOwnedJobs []*batchv1.Job
+		failedPods      map[string][]corev1.Pod
 
 		expectedFailurePolicyRule *jobset.FailurePolicyRule
 		expectedJob               *batchv1.Job
@@ -408,11 +528,23 @@ func TestFindFirstFailedPolicyRuleAndJob(t *testing.T) {
 			expectedFailurePolicyRule: &failurePolicyRule1,
 			expectedJob:               failedJob1DupEarlierFailure,
 		},
+		{
+			name:            "There are 2 failed jobs, there is 1 failure policy rule on pod exit codes, and the failure policy rule only matches the failed pods of the second job, therefore the second job and the failure policy rule are returned.",
+			rules:           []jobset.FailurePolicyRule{exitCodeFailurePolicyRule},
+			failedOwnedJobs: []*batchv1.Job{failedJob1DupEarlierFailure, failedJob2},
+			failedPods: map[string][]corev1.Pod{
+				failedJob1DupEarlierFailure.Name: {failedPodWithExitCode("pod-0", "main", 1)},
+				failedJob2.Name:                  {failedPodWithExitCode("pod-1", "main", 42)},
+			},
+
+			expectedFailurePolicyRule: &exitCodeFailurePolicyRule,
+			expectedJob:               failedJob2,
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			actualRule, actualJob := findFirstFailedPolicyRuleAndJob(context.TODO(), tc.rules, tc.failedOwnedJobs)
+			actualRule, actualJob := findFirstFailedPolicyRuleAndJob(context.TODO(), tc.rules, tc.failedOwnedJobs, tc.failedPods)
 			if diff := cmp.Diff(tc.expectedJob, actualJob); diff != "" {
 				t.Errorf("unexpected finished value (+got/-want): %s", diff)
 			}
@@ -424,14 +556,17 @@ func TestFindFirstFailedPolicyRuleAndJob(t *testing.T) {
 }
 
 func TestApplyFailurePolicyRuleAction(t *testing.T) {
-	matchingFailedJob := jobWithFailedCondition("failed-job", time.Now())
+	now := time.Now()
+	matchingFailedJob := jobWithFailedCondition("failed-job", now)
 
 	testCases := []struct {
 		name                 string
 		jobSet               *jobset.JobSet
+		matchingRule         *jobset.FailurePolicyRule
 		matchingFailedJob    *batchv1.Job
 		failurePolicyAction  jobset.FailurePolicyAction
 		expectedJobSetStatus jobset.JobSetStatus
+		expectedSuspend      bool
 	}{
 		{
 			name:                "FailJobSet action",
@@ -439,7 +574,33 @@ func TestApplyFailurePolicyRuleAction(t *testing.T) {
 			matchingFailedJob:   matchingFailedJob,
 			failurePolicyAction: jobset.FailJobSet,
 			expectedJobSetStatus: jobset.JobSetStatus{
-				TerminalState: string(jobset.JobSetFailed),
+				CompletionTime: ptr.To(metav1.NewTime(now)),
+				TerminalState:  string(jobset.JobSetFailed),
+				Conditions: []metav1.Condition{
+					{
+						Type:   string(jobset.JobSetFailed),
+						Status: metav1.ConditionTrue,
+						Reason: constants.FailJobSetActionReason,
+					},
+				},
+			},
+		},
+		{
+			name: "FailJobSet action records the time the jobset was active",
+			jobSet: testutils.MakeJobSet("test-js", "default").FailurePolicy(&jobset.FailurePolicy{}).
+				SetStatus(jobset.JobSetStatus{
+					StartTime:         ptr.To(metav1.NewTime(now.Add(-time.Hour))),
+					SuspendedDuration: &metav1.Duration{Duration: 10 * time.Minute},
+				}).
+				Obj(),
+			matchingFailedJob:   matchingFailedJob,
+			failurePolicyAction: jobset.FailJobSet,
+			expectedJobSetStatus: jobset.JobSetStatus{
+				StartTime:         ptr.To(metav1.NewTime(now.Add(-time.Hour))),
+				SuspendedDuration: &metav1.Duration{Duration: 10 * time.Minute},
+				CompletionTime:    ptr.To(metav1.NewTime(now)),
+				ActiveDuration:    &metav1.Duration{Duration: 50 * time.Minute},
+				TerminalState:     string(jobset.JobSetFailed),
 				Conditions: []metav1.Condition{
 					{
 						Type:   string(jobset.JobSetFailed),
@@ -460,8 +621,12 @@ func TestApplyFailurePolicyRuleAction(t *testing.T) {
 			matchingFailedJob:   matchingFailedJob,
 			failurePolicyAction: jobset.RestartJobSet,
 			expectedJobSetStatus: jobset.JobSetStatus{
+				CurrentAttemptStartTime: ptr.To(metav1.NewTime(now)),
 				Restarts:                2,
 				RestartsCountTowardsMax: 2,
+				RestartHistory: []jobset.RestartHistoryEntry{
+					{Attempt: 2, Time: metav1.NewTime(now), FailedJob: "failed-job", Action: jobset.RestartJobSet},
+				},
 			},
 		},
 		{
@@ -476,6 +641,7 @@ func TestApplyFailurePolicyRuleAction(t *testing.T) {
 			matchingFailedJob:   matchingFailedJob,
 			failurePolicyAction: jobset.RestartJobSet,
 			expectedJobSetStatus: jobset.JobSetStatus{
+				CompletionTime:          ptr.To(metav1.NewTime(now)),
 				Restarts:                2,
 				RestartsCountTowardsMax: 2,
 				TerminalState:           string(jobset.JobSetFailed),
@@ -499,9 +665,368 @@ func TestApplyFailurePolicyRuleAction(t *testing.T) {
 				Obj(),
 			matchingFailedJob:   matchingFailedJob,
 			failurePolicyAction: jobset.RestartJobSetAndIgnoreMaxRestarts,
+			expectedJobSetStatus: jobset.JobSetStatus{
+				CurrentAttemptStartTime: ptr.To(metav1.NewTime(now)),
+				Restarts:                2,
+				RestartsCountTowardsMax: 1, // not incremented
+				RestartHistory: []jobset.RestartHistoryEntry{
+					{Attempt: 2, Time: metav1.NewTime(now), FailedJob: "failed-job", Action: jobset.RestartJobSetAndIgnoreMaxRestarts},
+				},
+			},
+		},
+		{
+			name: "RestartJobSet action with RecreateFailedJobs restart strategy only recreates the failed job",
+			jobSet: testutils.MakeJobSet("test-js", "default").
+				FailurePolicy(&jobset.FailurePolicy{MaxRestarts: 5, RestartStrategy: jobset.RecreateFailedJobs}).
+				SetStatus(jobset.JobSetStatus{
+					Restarts:                1,
+					RestartsCountTowardsMax: 1,
+					JobRestarts: []jobset.JobRestartStatus{
+						{Name: "other-job", Restarts: 1},
+					},
+				}).
+				Obj(),
+			matchingFailedJob:   matchingFailedJob,
+			failurePolicyAction: jobset.RestartJobSet,
+			expectedJobSetStatus: jobset.JobSetStatus{
+				Restarts:                2,
+				RestartsCountTowardsMax: 2,
+				JobRestarts: []jobset.JobRestartStatus{
+					{Name: "other-job", Restarts: 1},
+					{Name: "failed-job", Restarts: 1},
+				},
+				RestartHistory: []jobset.RestartHistoryEntry{
+					{Attempt: 1, Time: metav1.NewTime(now), FailedJob: "failed-job", Action: jobset.RestartJobSet},
+				},
+			},
+		},
+		{
+			name: "RestartJobSet action with RecreateFailedJobs restart strategy increments the restarts of a recreated job",
+			jobSet: testutils.MakeJobSet("test-js", "default").
+				FailurePolicy(&jobset.FailurePolicy{MaxRestarts: 5, RestartStrategy: jobset.RecreateFailedJobs}).
+				SetStatus(jobset.JobSetStatus{
+					Restarts:                1,
+					RestartsCountTowardsMax: 1,
+					JobRestarts: []jobset.JobRestartStatus{
+						{Name: "failed-job", Restarts: 1},
+					},
+				}).
+				Obj(),
+			matchingFailedJob:   matchingFailedJob,
+			failurePolicyAction: jobset.RestartJobSet,
+			expectedJobSetStatus: jobset.JobSetStatus{
+				Restarts:                2,
+				RestartsCountTowardsMax: 2,
+				JobRestarts: []jobset.JobRestartStatus{
+					{Name: "failed-job", Restarts: 2},
+				},
+				RestartHistory: []jobset.RestartHistoryEntry{
+					{Attempt: 2, Time: metav1.NewTime(now), FailedJob: "failed-job", Action: jobset.RestartJobSet},
+				},
+			},
+		},
+		{
+			name: "RestartJobSetAndIgnoreMaxRestarts action with RecreateFailedJobs restart strategy does not count toward max restarts",
+			jobSet: testutils.MakeJobSet("test-js", "default").
+				FailurePolicy(&jobset.FailurePolicy{MaxRestarts: 1, RestartStrategy: jobset.RecreateFailedJobs}).
+				SetStatus(jobset.JobSetStatus{
+					Restarts:                1,
+					RestartsCountTowardsMax: 1,
+				}).
+				Obj(),
+			matchingFailedJob:   matchingFailedJob,
+			failurePolicyAction: jobset.RestartJobSetAndIgnoreMaxRestarts,
 			expectedJobSetStatus: jobset.JobSetStatus{
 				Restarts:                2,
 				RestartsCountTowardsMax: 1, // not incremented
+				JobRestarts: []jobset.JobRestartStatus{
+					{Name: "failed-job", Restarts: 1},
+				},
+				RestartHistory: []jobset.RestartHistoryEntry{
+					{Attempt: 1, Time: metav1.NewTime(now), FailedJob: "failed-job", Action: jobset.RestartJobSetAndIgnoreMaxRestarts},
+				},
+			},
+		},
+		{
+			name: "RestartJobSet with a replicatedJob max restarts counts towards the replicatedJob budget only",
+			jobSet: testutils.MakeJobSet("test-js", "default").
+				FailurePolicy(&jobset.FailurePolicy{
+					MaxRestarts: 1,
+					ReplicatedJobMaxRestarts: []jobset.ReplicatedJobMaxRestarts{
+						{Name: "evaluator", MaxRestarts: 3},
+					},
+				}).
+				SetStatus(jobset.JobSetStatus{
+					Restarts:                2,
+					RestartsCountTowardsMax: 1,
+					ReplicatedJobsStatus: []jobset.ReplicatedJobStatus{
+						{Name: "evaluator", Restarts: 1, RestartsCountTowardsMax: 1},
+					},
+				}).
+				Obj(),
+			matchingFailedJob:   jobWithFailedConditionAndOpts("failed-job", time.Now(), &failJobOptions{parentReplicatedJobName: ptr.To("evaluator")}),
+			failurePolicyAction: jobset.RestartJobSet,
+			expectedJobSetStatus: jobset.JobSetStatus{
+				CurrentAttemptStartTime: ptr.To(metav1.NewTime(now)),
+				Restarts:                3,
+				RestartsCountTowardsMax: 1,
+				ReplicatedJobsStatus: []jobset.ReplicatedJobStatus{
+					{Name: "evaluator", Restarts: 2, RestartsCountTowardsMax: 2},
+				},
+				RestartHistory: []jobset.RestartHistoryEntry{
+					{Attempt: 3, Time: metav1.NewTime(now), FailedJob: "failed-job", Action: jobset.RestartJobSet},
+				},
+			},
+		},
+		{
+			name: "RestartJobSet without a replicatedJob max restarts counts towards the jobset budget only",
+			jobSet: testutils.MakeJobSet("test-js", "default").
+				FailurePolicy(&jobset.FailurePolicy{
+					MaxRestarts: 2,
+					ReplicatedJobMaxRestarts: []jobset.ReplicatedJobMaxRestarts{
+						{Name: "evaluator", MaxRestarts: 3},
+					},
+				}).
+				Obj(),
+			matchingFailedJob:   jobWithFailedConditionAndOpts("failed-job", time.Now(), &failJobOptions{parentReplicatedJobName: ptr.To("trainer")}),
+			failurePolicyAction: jobset.RestartJobSet,
+			expectedJobSetStatus: jobset.JobSetStatus{
+				CurrentAttemptStartTime: ptr.To(metav1.NewTime(now)),
+				Restarts:                1,
+				RestartsCountTowardsMax: 1,
+				ReplicatedJobsStatus: []jobset.ReplicatedJobStatus{
+					{Name: "trainer", Restarts: 1},
+				},
+				RestartHistory: []jobset.RestartHistoryEntry{
+					{Attempt: 1, Time: metav1.NewTime(now), FailedJob: "failed-job", Action: jobset.RestartJobSet},
+				},
+			},
+		},
+		{
+			name: "RestartJobSet with a rule max restarts is not exhausted by previous restarts counting towards the jobset budget",
+			jobSet: testutils.MakeJobSet("test-js", "default").
+				FailurePolicy(&jobset.FailurePolicy{MaxRestarts: 5}).
+				SetStatus(jobset.JobSetStatus{
+					Restarts:                2,
+					RestartsCountTowardsMax: 2,
+					ReplicatedJobsStatus: []jobset.ReplicatedJobStatus{
+						{Name: "trainer", Restarts: 2},
+					},
+				}).
+				Obj(),
+			matchingRule:        &jobset.FailurePolicyRule{Name: "rule", Action: jobset.RestartJobSet, MaxRestarts: ptr.To[int32](1)},
+			matchingFailedJob:   jobWithFailedConditionAndOpts("failed-job", time.Now(), &failJobOptions{parentReplicatedJobName: ptr.To("trainer")}),
+			failurePolicyAction: jobset.RestartJobSet,
+			expectedJobSetStatus: jobset.JobSetStatus{
+				CurrentAttemptStartTime: ptr.To(metav1.NewTime(now)),
+				Restarts:                3,
+				RestartsCountTowardsMax: 2,
+				ReplicatedJobsStatus: []jobset.ReplicatedJobStatus{
+					{Name: "trainer", Restarts: 3, RestartsCountTowardsMax: 1},
+				},
+				RestartHistory: []jobset.RestartHistoryEntry{
+					{Attempt: 3, Time: metav1.NewTime(now), FailedJob: "failed-job", FailurePolicyRule: "rule", Action: jobset.RestartJobSet},
+				},
+			},
+		},
+		{
+			name: "RestartJobSet without a rule max restarts is not exhausted by previous restarts counting towards the rule budget",
+			jobSet: testutils.MakeJobSet("test-js", "default").
+				FailurePolicy(&jobset.FailurePolicy{MaxRestarts: 1}).
+				SetStatus(jobset.JobSetStatus{
+					Restarts: 1,
+					ReplicatedJobsStatus: []jobset.ReplicatedJobStatus{
+						{Name: "trainer", Restarts: 1, RestartsCountTowardsMax: 1},
+					},
+				}).
+				Obj(),
+			matchingFailedJob:   jobWithFailedConditionAndOpts("failed-job", time.Now(), &failJobOptions{parentReplicatedJobName: ptr.To("trainer")}),
+			failurePolicyAction: jobset.RestartJobSet,
+			expectedJobSetStatus: jobset.JobSetStatus{
+				CurrentAttemptStartTime: ptr.To(metav1.NewTime(now)),
+				Restarts:                2,
+				RestartsCountTowardsMax: 1,
+				ReplicatedJobsStatus: []jobset.ReplicatedJobStatus{
+					{Name: "trainer", Restarts: 2, RestartsCountTowardsMax: 1},
+				},
+				RestartHistory: []jobset.RestartHistoryEntry{
+					{Attempt: 2, Time: metav1.NewTime(now), FailedJob: "failed-job", Action: jobset.RestartJobSet},
+				},
+			},
+		},
+		{
+			name: "RestartJobSet action fails the jobset when the replicatedJob max restarts is reached",
+			jobSet: testutils.MakeJobSet("test-js", "default").
+				FailurePolicy(&jobset.FailurePolicy{
+					MaxRestarts: 5,
+					ReplicatedJobMaxRestarts: []jobset.ReplicatedJobMaxRestarts{
+						{Name: "evaluator", MaxRestarts: 1},
+					},
+				}).
+				SetStatus(jobset.JobSetStatus{
+					Restarts: 1,
+					ReplicatedJobsStatus: []jobset.ReplicatedJobStatus{
+						{Name: "evaluator", Restarts: 1, RestartsCountTowardsMax: 1},
+					},
+				}).
+				Obj(),
+			matchingFailedJob:   jobWithFailedConditionAndOpts("failed-job", time.Now(), &failJobOptions{parentReplicatedJobName: ptr.To("evaluator")}),
+			failurePolicyAction: jobset.RestartJobSet,
+			expectedJobSetStatus: jobset.JobSetStatus{
+				CompletionTime: ptr.To(metav1.NewTime(now)),
+				Restarts:       1,
+				TerminalState:  string(jobset.JobSetFailed),
+				ReplicatedJobsStatus: []jobset.ReplicatedJobStatus{
+					{Name: "evaluator", Restarts: 1, RestartsCountTowardsMax: 1},
+				},
+				Conditions: []metav1.Condition{
+					{
+						Type:   string(jobset.JobSetFailed),
+						Status: metav1.ConditionTrue,
+						Reason: constants.ReachedMaxRestartsReason,
+					},
+				},
+			},
+		},
+		{
+			name: "RestartJobSet action uses the rule max restarts over the replicatedJob max restarts",
+			jobSet: testutils.MakeJobSet("test-js", "default").
+				FailurePolicy(&jobset.FailurePolicy{
+					MaxRestarts: 5,
+					ReplicatedJobMaxRestarts: []jobset.ReplicatedJobMaxRestarts{
+						{Name: "evaluator", MaxRestarts: 1},
+					},
+				}).
+				SetStatus(jobset.JobSetStatus{
+					Restarts: 1,
+					ReplicatedJobsStatus: []jobset.ReplicatedJobStatus{
+						{Name: "evaluator", Restarts: 1, RestartsCountTowardsMax: 1},
+					},
+				}).
+				Obj(),
+			matchingRule:        &jobset.FailurePolicyRule{Name: "rule", Action: jobset.RestartJobSet, MaxRestarts: ptr.To[int32](2)},
+			matchingFailedJob:   jobWithFailedConditionAndOpts("failed-job", time.Now(), &failJobOptions{parentReplicatedJobName: ptr.To("evaluator")}),
+			failurePolicyAction: jobset.RestartJobSet,
+			expectedJobSetStatus: jobset.JobSetStatus{
+				CurrentAttemptStartTime: ptr.To(metav1.NewTime(now)),
+				Restarts:                2,
+				ReplicatedJobsStatus: []jobset.ReplicatedJobStatus{
+					{Name: "evaluator", Restarts: 2, RestartsCountTowardsMax: 2},
+				},
+				RestartHistory: []jobset.RestartHistoryEntry{
+					{Attempt: 2, Time: metav1.NewTime(now), FailedJob: "failed-job", FailurePolicyRule: "rule", Action: jobset.RestartJobSet},
+				},
+			},
+		},
+		{
+			name: "RestartJobSet with a restart window records the restart time",
+			jobSet: testutils.MakeJobSet("test-js", "default").
+				FailurePolicy(&jobset.FailurePolicy{MaxRestarts: 2, RestartsWindowSeconds: ptr.To[int32](3600)}).
+				SetStatus(jobset.JobSetStatus{
+					Restarts:                1,
+					RestartsCountTowardsMax: 1,
+					RestartTimes:            []metav1.Time{metav1.NewTime(now.Add(-time.Minute))},
+				}).
+				Obj(),
+			matchingFailedJob:   matchingFailedJob,
+			failurePolicyAction: jobset.RestartJobSet,
+			expectedJobSetStatus: jobset.JobSetStatus{
+				CurrentAttemptStartTime: ptr.To(metav1.NewTime(now)),
+				Restarts:                2,
+				RestartsCountTowardsMax: 2,
+				RestartTimes:            []metav1.Time{metav1.NewTime(now.Add(-time.Minute)), metav1.NewTime(now)},
+				RestartHistory: []jobset.RestartHistoryEntry{
+					{Attempt: 2, Time: metav1.NewTime(now), FailedJob: "failed-job", Action: jobset.RestartJobSet},
+				},
+			},
+		},
+		{
+			name: "RestartJobSet with a restart window records the restart time of the replicatedJob budget",
+			jobSet: testutils.MakeJobSet("test-js", "default").
+				FailurePolicy(&jobset.FailurePolicy{
+					MaxRestarts:           1,
+					RestartsWindowSeconds: ptr.To[int32](3600),
+					ReplicatedJobMaxRestarts: []jobset.ReplicatedJobMaxRestarts{
+						{Name: "evaluator", MaxRestarts: 3},
+					},
+				}).
+				SetStatus(jobset.JobSetStatus{
+					Restarts: 1,
+					ReplicatedJobsStatus: []jobset.ReplicatedJobStatus{
+						{Name: "evaluator", Restarts: 1, RestartsCountTowardsMax: 1, RestartTimes: []metav1.Time{metav1.NewTime(now.Add(-time.Minute))}},
+					},
+				}).
+				Obj(),
+			matchingFailedJob:   jobWithFailedConditionAndOpts("failed-job", time.Now(), &failJobOptions{parentReplicatedJobName: ptr.To("evaluator")}),
+			failurePolicyAction: jobset.RestartJobSet,
+			expectedJobSetStatus: jobset.JobSetStatus{
+				CurrentAttemptStartTime: ptr.To(metav1.NewTime(now)),
+				Restarts:                2,
+				ReplicatedJobsStatus: []jobset.ReplicatedJobStatus{
+					{Name: "evaluator", Restarts: 2, RestartsCountTowardsMax: 2, RestartTimes: []metav1.Time{metav1.NewTime(now.Add(-time.Minute)), metav1.NewTime(now)}},
+				},
+				RestartHistory: []jobset.RestartHistoryEntry{
+					{Attempt: 2, Time: metav1.NewTime(now), FailedJob: "failed-job", Action: jobset.RestartJobSet},
+				},
+			},
+		},
+		{
+			name: "SuspendJobSet action suspends and holds the jobset",
+			jobSet: testutils.MakeJobSet("test-js", "default").
+				FailurePolicy(&jobset.FailurePolicy{MaxRestarts: 1}).
+				SetStatus(jobset.JobSetStatus{
+					Restarts:                1,
+					RestartsCountTowardsMax: 1,
+				}).
+				Obj(),
+			matchingFailedJob:   matchingFailedJob,
+			failurePolicyAction: jobset.SuspendJobSet,
+			expectedJobSetStatus: jobset.JobSetStatus{
+				Restarts:                1,
+				RestartsCountTowardsMax: 1,
+				Conditions: []metav1.Condition{
+					{
+						Type:   string(jobset.JobSetHeld),
+						Status: metav1.ConditionTrue,
+						Reason: constants.SuspendJobSetActionReason,
+					},
+				},
+			},
+			expectedSuspend: true,
+		},
+		{
+			name: "SuspendJobSet action restarts the held jobset once resumed without counting toward max restarts",
+			jobSet: testutils.MakeJobSet("test-js", "default").
+				FailurePolicy(&jobset.FailurePolicy{MaxRestarts: 1}).
+				Suspend(false).
+				SetStatus(jobset.JobSetStatus{
+					Restarts:                1,
+					RestartsCountTowardsMax: 1,
+					Conditions: []metav1.Condition{
+						{
+							Type:   string(jobset.JobSetHeld),
+							Status: metav1.ConditionTrue,
+							Reason: constants.SuspendJobSetActionReason,
+						},
+					},
+				}).
+				Obj(),
+			matchingFailedJob:   matchingFailedJob,
+			failurePolicyAction: jobset.SuspendJobSet,
+			expectedJobSetStatus: jobset.JobSetStatus{
+				CurrentAttemptStartTime: ptr.To(metav1.NewTime(now)),
+				Restarts:                2,
+				RestartsCountTowardsMax: 1, // not incremented
+				Conditions: []metav1.Condition{
+					{
+						Type:   string(jobset.JobSetHeld),
+						Status: metav1.ConditionFalse,
+						Reason: constants.ResumedAfterSuspendJobSetActionReason,
+					},
+				},
+				RestartHistory: []jobset.RestartHistoryEntry{
+					{Attempt: 2, Time: metav1.NewTime(now), FailedJob: "failed-job", Action: jobset.SuspendJobSet},
+				},
 			},
 		},
 	}
@@ -510,7 +1035,7 @@ func TestApplyFailurePolicyRuleAction(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			updateStatusOpts := &statusUpdateOpts{}
 			jobSetCopy := tc.jobSet.DeepCopy()
-			err := applyFailurePolicyRuleAction(context.TODO(), jobSetCopy, tc.matchingFailedJob, updateStatusOpts, tc.failurePolicyAction)
+			err := applyFailurePolicyRuleAction(context.TODO(), clocktesting.NewFakeClock(now), jobSetCopy, tc.matchingRule, tc.matchingFailedJob, updateStatusOpts, tc.failurePolicyAction)
 			if err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
@@ -527,6 +1052,128 @@ func TestApplyFailurePolicyRuleAction(t *testing.T) {
 			if diff := cmp.Diff(tc.expectedJobSetStatus, jobSetCopy.Status, opts...); diff != "" {
 				t.Errorf("unexpected JobSetStatus value after applying failure policy rule action (+got/-want): %s", diff)
 			}
+
+			if updateStatusOpts.shouldSuspend != tc.expectedSuspend {
+				t.Errorf("unexpected updateStatusOpts.shouldSuspend value: got %v, want %v", updateStatusOpts.shouldSuspend, tc.expectedSuspend)
+			}
+		})
+	}
+}
+
+// failedPodWithExitCode returns a failed pod with a container terminated with the given exit code.
+func failedPodWithExitCode(podName, containerName string, exitCode int32) corev1.Pod {
+	pod := testutils.MakePod(podName, "default").Obj()
+	pod.Status.Phase = corev1.PodFailed
+	pod.Status.ContainerStatuses = []corev1.ContainerStatus{
+		{
+			Name: containerName,
+			State: corev1.ContainerState{
+				Terminated: &corev1.ContainerStateTerminated{ExitCode: exitCode},
+			},
+		},
+	}
+	return pod
+}
+
+func TestRecordRestart(t *testing.T) {
+	now := time.Now()
+	failedJob := jobWithFailedConditionAndOpts("failed-job", time.Now(), &failJobOptions{reason: ptr.To(batchv1.JobReasonBackoffLimitExceeded)})
+	failedJob.Status.Conditions[0].Message = "Job has reached the specified backoff limit"
+
+	var fullHistory []jobset.RestartHistoryEntry
+	for attempt := int32(1); attempt <= maxRestartHistory; attempt++ {
+		fullHistory = append(fullHistory, jobset.RestartHistoryEntry{Attempt: attempt, Action: jobset.RestartJobSet})
+	}
+
+	testCases := []struct {
+		name          string
+		failurePolicy *jobset.FailurePolicy
+		status        jobset.JobSetStatus
+		rule          *jobset.FailurePolicyRule
+		failedJob     *batchv1.Job
+		action        jobset.FailurePolicyAction
+		wantHistory   []jobset.RestartHistoryEntry
+	}{
+		{
+			name:      "restart with the default action",
+			status:    jobset.JobSetStatus{Restarts: 1},
+			failedJob: failedJob,
+			action:    jobset.RestartJobSet,
+			wantHistory: []jobset.RestartHistoryEntry{
+				{
+					Attempt:   1,
+					Time:      metav1.NewTime(now),
+					FailedJob: "failed-job",
+					Action:    jobset.RestartJobSet,
+					Reason:    batchv1.JobReasonBackoffLimitExceeded,
+					Message:   "Job has reached the specified backoff limit",
+				},
+			},
+		},
+		{
+			name: "restart with a matching rule is appended to the history",
+			status: jobset.JobSetStatus{
+				Restarts:       2,
+				RestartHistory: []jobset.RestartHistoryEntry{{Attempt: 1, Action: jobset.RestartJobSet}},
+			},
+			rule:      &jobset.FailurePolicyRule{Name: "ignoreMaxRestarts"},
+			failedJob: failedJob,
+			action:    jobset.RestartJobSetAndIgnoreMaxRestarts,
+			wantHistory: []jobset.RestartHistoryEntry{
+				{Attempt: 1, Action: jobset.RestartJobSet},
+				{
+					Attempt:           2,
+					Time:              metav1.NewTime(now),
+					FailedJob:         "failed-job",
+					FailurePolicyRule: "ignoreMaxRestarts",
+					Action:            jobset.RestartJobSetAndIgnoreMaxRestarts,
+					Reason:            batchv1.JobReasonBackoffLimitExceeded,
+					Message:           "Job has reached the specified backoff limit",
+				},
+			},
+		},
+		{
+			name:      "oldest restarts are dropped from a full history",
+			status:    jobset.JobSetStatus{Restarts: maxRestartHistory + 1, RestartHistory: fullHistory},
+			failedJob: jobWithFailedCondition("failed-job", time.Now()),
+			action:    jobset.RestartJobSet,
+			wantHistory: append(slices.Clone(fullHistory[1:]), jobset.RestartHistoryEntry{
+				Attempt:   maxRestartHistory + 1,
+				Time:      metav1.NewTime(now),
+				FailedJob: "failed-job",
+				Action:    jobset.RestartJobSet,
+			}),
+		},
+		{
+			name:          "restart with RecreateFailedJobs records the restart attempt of the recreated job",
+			failurePolicy: &jobset.FailurePolicy{RestartStrategy: jobset.RecreateFailedJobs},
+			status: jobset.JobSetStatus{
+				Restarts: 3,
+				JobRestarts: []jobset.JobRestartStatus{
+					{Name: "other-job", Restarts: 2},
+					{Name: "failed-job", Restarts: 1},
+				},
+			},
+			failedJob: jobWithFailedCondition("failed-job", time.Now()),
+			action:    jobset.RestartJobSet,
+			wantHistory: []jobset.RestartHistoryEntry{
+				{
+					Attempt:   1,
+					Time:      metav1.NewTime(now),
+					FailedJob: "failed-job",
+					Action:    jobset.RestartJobSet,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			js := testutils.MakeJobSet("test-js", "default").FailurePolicy(tc.failurePolicy).SetStatus(tc.status).Obj()
+			recordRestart(clocktesting.NewFakeClock(now), js, tc.rule, tc.failedJob, tc.action)
+			if diff := cmp.Diff(tc.wantHistory, js.Status.RestartHistory); diff != "" {
+				t.Errorf("unexpected restart history (-want/+got): %s", diff)
+			}
 		})
 	}
 }
@@ -0,0 +1,199 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+)
+
+// defaultFrameworkPorts are the rendezvous ports used by the framework presets when not set.
+var defaultFrameworkPorts = map[jobset.FrameworkName]int32{
+	jobset.PyTorch:    29500,
+	jobset.JAX:        1234,
+	jobset.TensorFlow: 2222,
+}
+
+// tfConfig is the TF_CONFIG environment variable read by TensorFlow distribution strategies.
+type tfConfig struct {
+	Cluster map[string][]string `json:"cluster"`
+	Task    tfTask              `json:"task"`
+}
+
+type tfTask struct {
+	Type  string `json:"type"`
+	Index int    `json:"index"`
+}
+
+// frameworkFor returns the framework preset of the given ReplicatedJob, which is its own framework
+// if set, or the framework of the JobSet otherwise.
+func frameworkFor(js *jobset.JobSet, rjob *jobset.ReplicatedJob) *jobset.Framework {
+	if rjob.Framework != nil {
+		return rjob.Framework
+	}
+	return js.Spec.Framework
+}
+
+// frameworkPort returns the rendezvous port of the framework preset.
+func frameworkPort(framework *jobset.Framework) int32 {
+	return ptr.Deref(framework.Port, defaultFrameworkPorts[framework.Name])
+}
+
+// podsPerJob returns the number of pods, i.e. completion indexes, of each child Job of the
+// given ReplicatedJob.
+func podsPerJob(rjob *jobset.ReplicatedJob) int {
+	return int(ptr.Deref(rjob.Template.Spec.Completions, ptr.Deref(rjob.Template.Spec.Parallelism, 1)))
+}
+
+// podHostname returns the fully qualified hostname of a pod of the given ReplicatedJob.
+func podHostname(js *jobset.JobSet, rjob *jobset.ReplicatedJob, jobIdx, podIdx int) string {
+	return fmt.Sprintf("%s-%s-%d-%d.%s", js.Name, rjob.Name, jobIdx, podIdx, replicatedJobSubdomain(js, rjob))
+}
+
+// replicatedJobHostnames returns the hostnames of all the pods of the given ReplicatedJob with the
+// given replicas, ordered by job index and completion index.
+func replicatedJobHostnames(js *jobset.JobSet, rjob *jobset.ReplicatedJob, replicas int32) []string {
+	var hostnames []string
+	for jobIdx := 0; jobIdx < int(replicas); jobIdx++ {
+		for podIdx := 0; podIdx < podsPerJob(rjob); podIdx++ {
+			hostnames = append(hostnames, podHostname(js, rjob, jobIdx, podIdx))
+		}
+	}
+	return hostnames
+}
+
+// frameworkWorld returns the ReplicatedJobs using the given framework, whose pods form the world
+// of the pods of each of them, in the order they are listed.
+func frameworkWorld(js *jobset.JobSet, framework *jobset.Framework) []*jobset.ReplicatedJob {
+	var world []*jobset.ReplicatedJob
+	for i := range js.Spec.ReplicatedJobs {
+		member := &js.Spec.ReplicatedJobs[i]
+		if f := frameworkFor(js, member); f != nil && f.Name == framework.Name {
+			world = append(world, member)
+		}
+	}
+	return world
+}
+
+// frameworkReplicas returns the value of the framework replicas annotation of the pods using the
+// given framework, i.e. the current replicas of the ReplicatedJobs of their framework world.
+func frameworkReplicas(js *jobset.JobSet, framework *jobset.Framework) string {
+	var pairs []string
+	for _, member := range frameworkWorld(js, framework) {
+		pairs = append(pairs, fmt.Sprintf("%s=%d", member.Name, member.Replicas))
+	}
+	return strings.Join(pairs, ",")
+}
+
+// ParseFrameworkReplicas parses the value of the framework replicas annotation of a pod into the
+// replicas of the ReplicatedJobs of its framework world, keyed by ReplicatedJob name.
+func ParseFrameworkReplicas(value string) (map[string]int32, error) {
+	replicas := map[string]int32{}
+	for _, pair := range strings.Split(value, ",") {
+		name, count, found := strings.Cut(pair, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid replicated job replicas %q, must be <replicatedJob>=<replicas>", pair)
+		}
+		n, err := strconv.ParseInt(count, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid replicas of replicated job %q: %w", name, err)
+		}
+		replicas[name] = int32(n)
+	}
+	return replicas, nil
+}
+
+// FrameworkEnv returns the rendezvous environment variables of the framework preset of the
+// given ReplicatedJob for the pod with the given job index and completion index, or nil if the
+// ReplicatedJob has no framework preset.
+// The pods of all the ReplicatedJobs using the same framework form the world of the pod, and
+// are ranked in the order the ReplicatedJobs are listed, then by job index and completion index.
+// The size of the world is computed from the given replicas, which are the replicas of the
+// ReplicatedJobs when the Job of the pod was created. The ReplicatedJobs missing from them
+// use their current replicas.
+func FrameworkEnv(js *jobset.JobSet, rjob *jobset.ReplicatedJob, replicas map[string]int32, jobIdx, podIdx int) []corev1.EnvVar {
+	framework := frameworkFor(js, rjob)
+	if framework == nil {
+		return nil
+	}
+
+	world := frameworkWorld(js, framework)
+	memberReplicas := func(member *jobset.ReplicatedJob) int32 {
+		if r, ok := replicas[member.Name]; ok {
+			return r
+		}
+		return member.Replicas
+	}
+	rank, worldSize := 0, 0
+	for _, member := range world {
+		if member.Name == rjob.Name {
+			rank = worldSize + jobIdx*podsPerJob(member) + podIdx
+		}
+		worldSize += int(memberReplicas(member)) * podsPerJob(member)
+	}
+	// The rendezvous is hosted by the pod of rank 0.
+	masterAddr := podHostname(js, world[0], 0, 0)
+	masterPort := strconv.Itoa(int(frameworkPort(frameworkFor(js, world[0]))))
+
+	switch framework.Name {
+	case jobset.PyTorch:
+		// Each pod is a torchrun node, which derives the RANK and WORLD_SIZE of its worker processes
+		// from the node rank and number of nodes, and its --nproc_per_node.
+		return []corev1.EnvVar{
+			{Name: "MASTER_ADDR", Value: masterAddr},
+			{Name: "MASTER_PORT", Value: masterPort},
+			{Name: "PET_MASTER_ADDR", Value: masterAddr},
+			{Name: "PET_MASTER_PORT", Value: masterPort},
+			{Name: "PET_NNODES", Value: strconv.Itoa(worldSize)},
+			{Name: "PET_NODE_RANK", Value: strconv.Itoa(rank)},
+		}
+	case jobset.JAX:
+		return []corev1.EnvVar{
+			{Name: "JAX_COORDINATOR_ADDRESS", Value: masterAddr + ":" + masterPort},
+			{Name: "JAX_NUM_PROCESSES", Value: strconv.Itoa(worldSize)},
+			{Name: "JAX_PROCESS_ID", Value: strconv.Itoa(rank)},
+		}
+	case jobset.TensorFlow:
+		config := tfConfig{
+			Cluster: map[string][]string{},
+			Task:    tfTask{Type: rjob.Name, Index: jobIdx*podsPerJob(rjob) + podIdx},
+		}
+		for _, member := range world {
+			port := frameworkPort(frameworkFor(js, member))
+			for _, hostname := range replicatedJobHostnames(js, member, memberReplicas(member)) {
+				config.Cluster[member.Name] = append(config.Cluster[member.Name], fmt.Sprintf("%s:%d", hostname, port))
+			}
+		}
+		// Marshalling a struct of strings, ints and maps of string slices cannot fail.
+		value, _ := json.Marshal(config)
+		return []corev1.EnvVar{{Name: "TF_CONFIG", Value: string(value)}}
+	case jobset.MPI:
+		var hosts []string
+		for _, member := range world {
+			hosts = append(hosts, replicatedJobHostnames(js, member, memberReplicas(member))...)
+		}
+		return []corev1.EnvVar{
+			{Name: "JOBSET_MPI_HOSTS", Value: strings.Join(hosts, ",")},
+			{Name: "OMPI_MCA_orte_keep_fqdn_hostnames", Value: "true"},
+		}
+	}
+	return nil
+}
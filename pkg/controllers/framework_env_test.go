@@ -0,0 +1,247 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	testutils "sigs.k8s.io/jobset/pkg/util/testing"
+)
+
+func TestFrameworkEnv(t *testing.T) {
+	var (
+		jobSetName = "test-jobset"
+		ns         = "default"
+	)
+
+	// makeJobSet returns a JobSet with a driver Job of 1 pod and 2 worker Jobs of 2 pods each.
+	makeJobSet := func(driverFramework, workersFramework *jobset.Framework) *testutils.JobSetWrapper {
+		workersTemplate := testutils.MakeJobTemplate("workers", ns).Obj()
+		workersTemplate.Spec.Completions = ptr.To[int32](2)
+		workersTemplate.Spec.Parallelism = ptr.To[int32](2)
+		return testutils.MakeJobSet(jobSetName, ns).
+			EnableDNSHostnames(true).
+			ReplicatedJob(testutils.MakeReplicatedJob("driver").
+				Job(testutils.MakeJobTemplate("driver", ns).Obj()).
+				Framework(driverFramework).
+				Replicas(1).
+				Obj()).
+			ReplicatedJob(testutils.MakeReplicatedJob("workers").
+				Job(workersTemplate).
+				Framework(workersFramework).
+				Replicas(2).
+				Obj())
+	}
+
+	tests := []struct {
+		name string
+		js   *jobset.JobSet
+		// rjobIdx, jobIdx and podIdx identify the pod the environment is computed for.
+		rjobIdx int
+		jobIdx  int
+		podIdx  int
+		// replicas are the replicas of the world when the Job of the pod was created.
+		replicas map[string]int32
+		want     []corev1.EnvVar
+	}{
+		{
+			name:    "no framework",
+			js:      makeJobSet(nil, nil).Obj(),
+			rjobIdx: 1,
+		},
+		{
+			name:    "pytorch",
+			js:      makeJobSet(nil, nil).Framework(&jobset.Framework{Name: jobset.PyTorch}).Obj(),
+			rjobIdx: 1,
+			jobIdx:  1,
+			podIdx:  1,
+			want: []corev1.EnvVar{
+				{Name: "MASTER_ADDR", Value: "test-jobset-driver-0-0.test-jobset"},
+				{Name: "MASTER_PORT", Value: "29500"},
+				{Name: "PET_MASTER_ADDR", Value: "test-jobset-driver-0-0.test-jobset"},
+				{Name: "PET_MASTER_PORT", Value: "29500"},
+				{Name: "PET_NNODES", Value: "5"},
+				{Name: "PET_NODE_RANK", Value: "4"},
+			},
+		},
+		{
+			name:    "pytorch on a replicated job, the other one using another framework",
+			js:      makeJobSet(&jobset.Framework{Name: jobset.JAX}, nil).Framework(&jobset.Framework{Name: jobset.PyTorch, Port: ptr.To[int32](3389)}).Obj(),
+			rjobIdx: 1,
+			jobIdx:  1,
+			podIdx:  0,
+			want: []corev1.EnvVar{
+				{Name: "MASTER_ADDR", Value: "test-jobset-workers-0-0.test-jobset"},
+				{Name: "MASTER_PORT", Value: "3389"},
+				{Name: "PET_MASTER_ADDR", Value: "test-jobset-workers-0-0.test-jobset"},
+				{Name: "PET_MASTER_PORT", Value: "3389"},
+				{Name: "PET_NNODES", Value: "4"},
+				{Name: "PET_NODE_RANK", Value: "2"},
+			},
+		},
+		{
+			name:     "pytorch world size is computed from the replicas when the job was created",
+			js:       makeJobSet(nil, nil).Framework(&jobset.Framework{Name: jobset.PyTorch}).Obj(),
+			rjobIdx:  1,
+			jobIdx:   0,
+			podIdx:   1,
+			replicas: map[string]int32{"driver": 1, "workers": 4},
+			want: []corev1.EnvVar{
+				{Name: "MASTER_ADDR", Value: "test-jobset-driver-0-0.test-jobset"},
+				{Name: "MASTER_PORT", Value: "29500"},
+				{Name: "PET_MASTER_ADDR", Value: "test-jobset-driver-0-0.test-jobset"},
+				{Name: "PET_MASTER_PORT", Value: "29500"},
+				{Name: "PET_NNODES", Value: "9"},
+				{Name: "PET_NODE_RANK", Value: "2"},
+			},
+		},
+		{
+			name:    "jax",
+			js:      makeJobSet(nil, nil).Framework(&jobset.Framework{Name: jobset.JAX}).Obj(),
+			rjobIdx: 0,
+			want: []corev1.EnvVar{
+				{Name: "JAX_COORDINATOR_ADDRESS", Value: "test-jobset-driver-0-0.test-jobset:1234"},
+				{Name: "JAX_NUM_PROCESSES", Value: "5"},
+				{Name: "JAX_PROCESS_ID", Value: "0"},
+			},
+		},
+		{
+			name:    "jax with a port, on a replicated job only",
+			js:      makeJobSet(nil, &jobset.Framework{Name: jobset.JAX, Port: ptr.To[int32](8476)}).Obj(),
+			rjobIdx: 1,
+			jobIdx:  1,
+			podIdx:  1,
+			want: []corev1.EnvVar{
+				{Name: "JAX_COORDINATOR_ADDRESS", Value: "test-jobset-workers-0-0.test-jobset:8476"},
+				{Name: "JAX_NUM_PROCESSES", Value: "4"},
+				{Name: "JAX_PROCESS_ID", Value: "3"},
+			},
+		},
+		{
+			name: "tensorflow with a replicated job subdomain",
+			js: func() *jobset.JobSet {
+				js := makeJobSet(nil, nil).Framework(&jobset.Framework{Name: jobset.TensorFlow}).Obj()
+				js.Spec.ReplicatedJobs[1].Network = &jobset.ReplicatedJobNetwork{Subdomain: "workers"}
+				return js
+			}(),
+			rjobIdx: 1,
+			jobIdx:  1,
+			podIdx:  0,
+			want: []corev1.EnvVar{
+				{Name: "TF_CONFIG", Value: `{"cluster":{"driver":["test-jobset-driver-0-0.test-jobset:2222"],` +
+					`"workers":["test-jobset-workers-0-0.workers:2222","test-jobset-workers-0-1.workers:2222","test-jobset-workers-1-0.workers:2222","test-jobset-workers-1-1.workers:2222"]},` +
+					`"task":{"type":"workers","index":2}}`},
+			},
+		},
+		{
+			name:     "mpi hosts are computed from the replicas when the job was created",
+			js:       makeJobSet(nil, nil).Framework(&jobset.Framework{Name: jobset.MPI}).Obj(),
+			rjobIdx:  0,
+			replicas: map[string]int32{"driver": 1, "workers": 1},
+			want: []corev1.EnvVar{
+				{Name: "JOBSET_MPI_HOSTS", Value: "test-jobset-driver-0-0.test-jobset,test-jobset-workers-0-0.test-jobset,test-jobset-workers-0-1.test-jobset"},
+				{Name: "OMPI_MCA_orte_keep_fqdn_hostnames", Value: "true"},
+			},
+		},
+		{
+			name:    "mpi",
+			js:      makeJobSet(nil, nil).Framework(&jobset.Framework{Name: jobset.MPI}).Obj(),
+			rjobIdx: 0,
+			want: []corev1.EnvVar{
+				{Name: "JOBSET_MPI_HOSTS", Value: "test-jobset-driver-0-0.test-jobset,test-jobset-workers-0-0.test-jobset,test-jobset-workers-0-1.test-jobset," +
+					"test-jobset-workers-1-0.test-jobset,test-jobset-workers-1-1.test-jobset"},
+				{Name: "OMPI_MCA_orte_keep_fqdn_hostnames", Value: "true"},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := FrameworkEnv(tc.js, &tc.js.Spec.ReplicatedJobs[tc.rjobIdx], tc.replicas, tc.jobIdx, tc.podIdx)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected framework env (-want/+got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestConstructJobFrameworkAnnotation(t *testing.T) {
+	js := testutils.MakeJobSet("test-jobset", "default").
+		Framework(&jobset.Framework{Name: jobset.PyTorch}).
+		ReplicatedJob(testutils.MakeReplicatedJob("driver").
+			Job(testutils.MakeJobTemplate("driver", "default").Obj()).
+			Framework(&jobset.Framework{Name: jobset.JAX}).
+			Replicas(1).
+			Obj()).
+		ReplicatedJob(testutils.MakeReplicatedJob("workers").
+			Job(testutils.MakeJobTemplate("workers", "default").Obj()).
+			Replicas(1).
+			Obj()).
+		Obj()
+
+	want := map[string]string{"driver": "JAX", "workers": "PyTorch"}
+	wantReplicas := map[string]string{"driver": "driver=1", "workers": "workers=1"}
+	for i := range js.Spec.ReplicatedJobs {
+		rjob := &js.Spec.ReplicatedJobs[i]
+		job := constructJob(js, rjob, 0)
+		if got := job.Spec.Template.Annotations[jobset.FrameworkKey]; got != want[rjob.Name] {
+			t.Errorf("unexpected %s annotation on the pods of %q: want %q, got %q", jobset.FrameworkKey, rjob.Name, want[rjob.Name], got)
+		}
+		if got := job.Spec.Template.Annotations[jobset.FrameworkReplicasKey]; got != wantReplicas[rjob.Name] {
+			t.Errorf("unexpected %s annotation on the pods of %q: want %q, got %q", jobset.FrameworkReplicasKey, rjob.Name, wantReplicas[rjob.Name], got)
+		}
+	}
+}
+
+func TestParseFrameworkReplicas(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    map[string]int32
+		wantErr bool
+	}{
+		{
+			name:  "replicas of the replicated jobs",
+			value: "driver=1,workers=4",
+			want:  map[string]int32{"driver": 1, "workers": 4},
+		},
+		{
+			name:    "missing replicas",
+			value:   "driver",
+			wantErr: true,
+		},
+		{
+			name:    "invalid replicas",
+			value:   "driver=one",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseFrameworkReplicas(tc.value)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected replicas (-want/+got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,307 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	"sigs.k8s.io/jobset/pkg/constants"
+)
+
+// reconcileSvcs ensures the services of the JobSet exist and match its spec: the headless services,
+// see constructHeadlessSvcs, and the service of the coordinator pod, see constructCoordinatorSvc.
+// The ServiceConflict condition is set if any of them conflicts with the JobSet.
+func (r *JobSetReconciler) reconcileSvcs(ctx context.Context, js *jobset.JobSet, updateStatusOpts *statusUpdateOpts) error {
+	desiredSvcs := constructHeadlessSvcs(js)
+	if coordinatorSvc := constructCoordinatorSvc(js); coordinatorSvc != nil {
+		desiredSvcs = append(desiredSvcs, coordinatorSvc)
+	}
+	if len(desiredSvcs) == 0 {
+		return nil
+	}
+
+	var conflicts []string
+	for _, desired := range desiredSvcs {
+		reconcile := r.reconcileSvc
+		if isNetworkGroupSvc(desired) {
+			reconcile = r.reconcileNetworkGroupSvc
+		}
+		conflict, err := reconcile(ctx, js, desired)
+		if err != nil {
+			return err
+		}
+		if conflict != "" {
+			conflicts = append(conflicts, conflict)
+		}
+	}
+	setCondition(js, makeServiceConflictConditionOpts(strings.Join(conflicts, "; ")), updateStatusOpts)
+	return nil
+}
+
+// reconcileSvc ensures the desired service of the JobSet exists and matches its spec:
+//  1. If the service does not exist, it is created.
+//  2. If the service is controlled by the JobSet, its selector, ports and publishNotReadyAddresses
+//     are updated if they drifted from the spec.
+//  3. If the service has no controller owner, has the desired type and selects the desired pods,
+//     it is adopted by the JobSet.
+//  4. Otherwise, the service conflicts with the JobSet and the conflict is returned.
+func (r *JobSetReconciler) reconcileSvc(ctx context.Context, js *jobset.JobSet, desired *corev1.Service) (string, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	var svc corev1.Service
+	if err := r.Get(ctx, types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}, &svc); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", err
+		}
+
+		// Set controller owner reference for garbage collection and reconcilation.
+		if err := ctrl.SetControllerReference(js, desired, r.Scheme); err != nil {
+			return "", err
+		}
+
+		// Create service.
+		if err := r.Create(ctx, desired); err != nil {
+			r.Record.Eventf(js, corev1.EventTypeWarning, constants.HeadlessServiceCreationFailedReason, err.Error())
+			return "", err
+		}
+		log.V(2).Info("successfully created service", "service", klog.KObj(desired))
+		return "", nil
+	}
+
+	if conflict := svcConflict(js, &svc, desired); conflict != "" {
+		log.V(2).Info("service conflicts with the jobset", "service", klog.KObj(&svc), "conflict", conflict)
+		return conflict, nil
+	}
+
+	owned := metav1.IsControlledBy(&svc, js)
+	drifted := !apiequality.Semantic.DeepEqual(svc.Spec.Selector, desired.Spec.Selector) ||
+		!apiequality.Semantic.DeepEqual(svc.Spec.Ports, desired.Spec.Ports) ||
+		svc.Spec.PublishNotReadyAddresses != desired.Spec.PublishNotReadyAddresses
+	if !owned || drifted {
+		if err := ctrl.SetControllerReference(js, &svc, r.Scheme); err != nil {
+			return "", err
+		}
+		svc.Spec.Selector = desired.Spec.Selector
+		svc.Spec.Ports = desired.Spec.Ports
+		svc.Spec.PublishNotReadyAddresses = desired.Spec.PublishNotReadyAddresses
+		if err := r.Update(ctx, &svc); err != nil {
+			return "", err
+		}
+		log.V(2).Info("successfully reconciled service", "service", klog.KObj(&svc), "adopted", !owned)
+	}
+	return "", nil
+}
+
+// constructHeadlessSvcs returns the headless services of the JobSet:
+//  1. The service named after the subdomain of the JobSet, selecting the pods of the JobSet,
+//     or the pods of all the JobSets of its network group if set, unless every ReplicatedJob
+//     has a network override.
+//  2. A service named after the subdomain of each ReplicatedJob with a network override,
+//     selecting only the pods of this ReplicatedJob.
+func constructHeadlessSvcs(js *jobset.JobSet) []*corev1.Service {
+	// Headless service is only necessary for indexed jobs whose pods need to communicate with
+	// eachother via pod hostnames.
+	if !dnsHostnamesEnabled(js) {
+		return nil
+	}
+
+	publishNotReadyAddresses := ptr.Deref(js.Spec.Network.PublishNotReadyAddresses, true)
+
+	var svcs []*corev1.Service
+	if len(js.Spec.ReplicatedJobs) == 0 || slices.ContainsFunc(js.Spec.ReplicatedJobs, func(rjob jobset.ReplicatedJob) bool { return rjob.Network == nil }) {
+		selector := map[string]string{jobset.JobSetNameKey: js.Name}
+		if group := networkGroup(js); group != "" {
+			selector = map[string]string{jobset.NetworkGroupKey: group}
+		}
+		svcs = append(svcs, constructHeadlessSvc(js, GetSubdomain(js), selector, publishNotReadyAddresses))
+	}
+	for _, rjob := range js.Spec.ReplicatedJobs {
+		if rjob.Network == nil {
+			continue
+		}
+		svcs = append(svcs, constructHeadlessSvc(js, replicatedJobSubdomain(js, &rjob), map[string]string{
+			jobset.JobSetNameKey:        js.Name,
+			jobset.ReplicatedJobNameKey: rjob.Name,
+		}, ptr.Deref(rjob.Network.PublishNotReadyAddresses, publishNotReadyAddresses)))
+	}
+	return svcs
+}
+
+// constructHeadlessSvc returns a headless service with the given name and selector.
+func constructHeadlessSvc(js *jobset.JobSet, name string, selector map[string]string, publishNotReadyAddresses bool) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: js.Namespace,
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP:                corev1.ClusterIPNone,
+			Selector:                 selector,
+			Ports:                    svcPorts(js.Spec.Network.Ports),
+			PublishNotReadyAddresses: publishNotReadyAddresses,
+		},
+	}
+}
+
+// svcPorts returns the service ports for the given network ports, with the target port
+// and protocol defaulted as done by the API server.
+func svcPorts(ports []jobset.NetworkPort) []corev1.ServicePort {
+	var svcPorts []corev1.ServicePort
+	for _, port := range ports {
+		svcPorts = append(svcPorts, corev1.ServicePort{
+			Name:       port.Name,
+			Port:       port.Port,
+			TargetPort: ptr.Deref(port.TargetPort, intstr.FromInt32(port.Port)),
+			Protocol:   cmp.Or(port.Protocol, corev1.ProtocolTCP),
+		})
+	}
+	return svcPorts
+}
+
+// reconcileNetworkGroupSvc ensures the headless service shared by the JobSets of the network group
+// of the JobSet exists and is owned by the JobSet. The service has no controller owner, but an owner
+// reference for each JobSet of the group, so it is only garbage collected once all of them are deleted.
+// If the service cannot be shared by the JobSet, the conflict is returned.
+func (r *JobSetReconciler) reconcileNetworkGroupSvc(ctx context.Context, js *jobset.JobSet, desired *corev1.Service) (string, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	var headlessSvc corev1.Service
+	if err := r.Get(ctx, types.NamespacedName{Name: desired.Name, Namespace: desired.Namespace}, &headlessSvc); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", err
+		}
+
+		if err := controllerutil.SetOwnerReference(js, desired, r.Scheme); err != nil {
+			return "", err
+		}
+		if err := r.Create(ctx, desired); err != nil {
+			r.Record.Eventf(js, corev1.EventTypeWarning, constants.HeadlessServiceCreationFailedReason, err.Error())
+			return "", err
+		}
+		log.V(2).Info("successfully created network group headless service", "service", klog.KObj(desired), "group", networkGroup(js))
+		return "", nil
+	}
+
+	if conflict := networkGroupSvcConflict(js, &headlessSvc, desired); conflict != "" {
+		log.V(2).Info("network group headless service conflicts with the jobset", "service", klog.KObj(&headlessSvc), "conflict", conflict)
+		return conflict, nil
+	}
+
+	ownerRefs := len(headlessSvc.OwnerReferences)
+	if err := controllerutil.SetOwnerReference(js, &headlessSvc, r.Scheme); err != nil {
+		return "", err
+	}
+	if len(headlessSvc.OwnerReferences) != ownerRefs {
+		if err := r.Update(ctx, &headlessSvc); err != nil {
+			return "", err
+		}
+		log.V(2).Info("successfully joined network group headless service", "service", klog.KObj(&headlessSvc), "group", networkGroup(js))
+	}
+	return "", nil
+}
+
+// isNetworkGroupSvc returns true if the given headless service is shared by the JobSets of a network group.
+func isNetworkGroupSvc(svc *corev1.Service) bool {
+	_, ok := svc.Spec.Selector[jobset.NetworkGroupKey]
+	return ok
+}
+
+// networkGroupSvcConflict returns why the existing service with the name of the desired network group
+// headless service cannot be shared by the JobSet, or an empty string if it can be.
+func networkGroupSvcConflict(js *jobset.JobSet, svc, desired *corev1.Service) string {
+	if svc.DeletionTimestamp != nil {
+		return fmt.Sprintf("service %q is being deleted", svc.Name)
+	}
+	if owner := metav1.GetControllerOf(svc); owner != nil {
+		return fmt.Sprintf("service %q is controlled by %s %q", svc.Name, owner.Kind, owner.Name)
+	}
+	if svc.Spec.ClusterIP != corev1.ClusterIPNone {
+		return fmt.Sprintf("service %q is not headless", svc.Name)
+	}
+	if !apiequality.Semantic.DeepEqual(svc.Spec.Selector, desired.Spec.Selector) {
+		return fmt.Sprintf("service %q does not select the pods of network group %q", svc.Name, networkGroup(js))
+	}
+	if svc.Spec.PublishNotReadyAddresses != desired.Spec.PublishNotReadyAddresses {
+		return fmt.Sprintf("service %q has a different publishNotReadyAddresses than the jobset", svc.Name)
+	}
+	if !apiequality.Semantic.DeepEqual(svc.Spec.Ports, desired.Spec.Ports) {
+		return fmt.Sprintf("service %q has different ports than the jobset", svc.Name)
+	}
+	return ""
+}
+
+// svcConflict returns why the existing service with the name of the desired service of the JobSet
+// cannot be reconciled by the JobSet, or an empty string if it can be.
+func svcConflict(js *jobset.JobSet, svc, desired *corev1.Service) string {
+	if svc.DeletionTimestamp != nil {
+		return fmt.Sprintf("service %q is being deleted", svc.Name)
+	}
+	if owner := metav1.GetControllerOf(svc); owner != nil && owner.UID != js.UID {
+		return fmt.Sprintf("service %q is controlled by %s %q", svc.Name, owner.Kind, owner.Name)
+	}
+	if svc.Spec.Type != "" && svc.Spec.Type != corev1.ServiceTypeClusterIP {
+		return fmt.Sprintf("service %q is of type %s", svc.Name, svc.Spec.Type)
+	}
+	if headless := desired.Spec.ClusterIP == corev1.ClusterIPNone; headless != (svc.Spec.ClusterIP == corev1.ClusterIPNone) {
+		if headless {
+			return fmt.Sprintf("service %q is not headless", svc.Name)
+		}
+		return fmt.Sprintf("service %q is headless", svc.Name)
+	}
+	if !metav1.IsControlledBy(svc, js) && !apiequality.Semantic.DeepEqual(svc.Spec.Selector, desired.Spec.Selector) {
+		return fmt.Sprintf("service %q is not owned by the jobset and its selector does not match the jobset pods", svc.Name)
+	}
+	return ""
+}
+
+// makeServiceConflictConditionOpts returns the options we use to generate the JobSet service conflict
+// condition. The condition is true with the given conflict as message, or false if the conflict is empty.
+func makeServiceConflictConditionOpts(conflict string) *conditionOpts {
+	if conflict == "" {
+		return &conditionOpts{
+			eventType: corev1.EventTypeNormal,
+			condition: &metav1.Condition{
+				Type:    string(jobset.JobSetServiceConflict),
+				Status:  metav1.ConditionFalse,
+				Reason:  constants.ServiceReconciledReason,
+				Message: constants.ServiceReconciledMessage,
+			},
+		}
+	}
+	return &conditionOpts{
+		eventType: corev1.EventTypeWarning,
+		condition: &metav1.Condition{
+			Type:    string(jobset.JobSetServiceConflict),
+			Status:  metav1.ConditionTrue,
+			Reason:  constants.ServiceConflictReason,
+			Message: conflict,
+		},
+	}
+}
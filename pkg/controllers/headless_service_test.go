@@ -0,0 +1,592 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2/ktesting"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	"sigs.k8s.io/jobset/pkg/constants"
+	testutils "sigs.k8s.io/jobset/pkg/util/testing"
+)
+
+func TestReconcileHeadlessSvcs(t *testing.T) {
+	var (
+		jobSetName = "test-jobset"
+		ns         = "default"
+	)
+
+	tests := []struct {
+		name                           string
+		jobSet                         *jobset.JobSet
+		existingService                bool
+		expectServiceCreate            bool
+		expectServiceName              string
+		expectPublishNotReadyAddresses bool
+		expectErr                      bool
+		expectErrStr                   string
+	}{
+		{
+			name:            "headless service exists and should not be created",
+			jobSet:          testutils.MakeJobSet(jobSetName, ns).EnableDNSHostnames(true).Obj(),
+			existingService: true,
+		},
+		{
+			name:            "headless service creation fails with unexpected error",
+			jobSet:          testutils.MakeJobSet(jobSetName, ns).EnableDNSHostnames(true).Obj(),
+			existingService: false,
+			expectErr:       true,
+			expectErrStr:    "unexpected error",
+		},
+		{
+			name:                           "service does not exist and should be created, subdomain not set",
+			jobSet:                         testutils.MakeJobSet(jobSetName, ns).EnableDNSHostnames(true).Obj(),
+			expectServiceCreate:            true,
+			expectServiceName:              "test-jobset",
+			expectPublishNotReadyAddresses: true,
+		},
+		{
+			name:                           "service does not exist and should be created, subdomain set",
+			jobSet:                         testutils.MakeJobSet(jobSetName, ns).EnableDNSHostnames(true).NetworkSubdomain("test-subdomain").Obj(),
+			expectServiceCreate:            true,
+			expectServiceName:              "test-subdomain",
+			expectPublishNotReadyAddresses: true,
+		},
+		{
+			name:                           "service does not exist and should be created, publishNotReadyAddresses is false",
+			jobSet:                         testutils.MakeJobSet(jobSetName, ns).EnableDNSHostnames(true).PublishNotReadyAddresses(false).Obj(),
+			expectServiceCreate:            true,
+			expectServiceName:              "test-jobset",
+			expectPublishNotReadyAddresses: false,
+		},
+		{
+			name:                           "service does not exist and should be created, publishNotReadyAddresses is true",
+			jobSet:                         testutils.MakeJobSet(jobSetName, ns).EnableDNSHostnames(true).PublishNotReadyAddresses(true).Obj(),
+			expectServiceCreate:            true,
+			expectServiceName:              "test-jobset",
+			expectPublishNotReadyAddresses: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var servicesCreated int
+			_, ctx := ktesting.NewTestContext(t)
+			scheme := runtime.NewScheme()
+			utilruntime.Must(jobset.AddToScheme(scheme))
+			utilruntime.Must(corev1.AddToScheme(scheme))
+			fakeClientBuilder := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(ctx context.Context, client client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					if tc.expectErr {
+						return errors.New("unexpected error")
+					}
+					if !tc.expectServiceCreate {
+						t.Fatal("unexpected service creation")
+					}
+					svc := obj.(*corev1.Service)
+					if svc.Name != tc.expectServiceName {
+						t.Errorf("expected service name to be %q, got %q", tc.expectServiceName, svc.Name)
+					}
+					if len(svc.OwnerReferences) != 1 {
+						t.Error("expected service to have owner reference set")
+					}
+					expectedOwnerRef := metav1.OwnerReference{
+						APIVersion:         "jobset.x-k8s.io/v1alpha2",
+						Kind:               "JobSet",
+						Name:               "test-jobset",
+						Controller:         ptr.To(true),
+						BlockOwnerDeletion: ptr.To(true),
+					}
+					if diff := cmp.Diff(expectedOwnerRef, svc.OwnerReferences[0]); diff != "" {
+						t.Errorf("unexpected service owner reference value (+got/-want): %s", diff)
+					}
+					if svc.Spec.ClusterIP != corev1.ClusterIPNone {
+						t.Errorf("expected service to have ClusterIP None, got %s", svc.Spec.ClusterIP)
+					}
+					selectorValue, ok := svc.Spec.Selector[jobset.JobSetNameKey]
+					if !ok {
+						t.Errorf("expected service selector to contain %q key", jobset.JobSetNameKey)
+					}
+					if selectorValue != tc.jobSet.Name {
+						t.Errorf("expected service selector to be %q, got %q", tc.jobSet.Name, selectorValue)
+					}
+					if svc.Spec.PublishNotReadyAddresses != tc.expectPublishNotReadyAddresses {
+						t.Errorf("expected PublishNotReadyAddresses to be %t, got %t", tc.expectPublishNotReadyAddresses, svc.Spec.PublishNotReadyAddresses)
+					}
+					servicesCreated++
+					return nil
+				},
+			})
+			if tc.existingService {
+				svc := &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-jobset",
+						Namespace: ns,
+					},
+				}
+				fakeClientBuilder.WithObjects(svc)
+			}
+			fakeClient := fakeClientBuilder.Build()
+
+			eventBroadcaster := record.NewBroadcaster()
+			recorder := eventBroadcaster.NewRecorder(scheme, corev1.EventSource{Component: "jobset-test-reconciler"})
+
+			// Create a JobSetReconciler instance with the fake client
+			r := &JobSetReconciler{
+				Client: fakeClient,
+				Scheme: scheme,
+				Record: recorder,
+			}
+
+			// Execute the function under test
+			gotErr := r.reconcileSvcs(ctx, tc.jobSet, &statusUpdateOpts{})
+			if tc.expectErr != (gotErr != nil) {
+				t.Errorf("expected error is %t, got %t, error: %v", tc.expectErr, gotErr != nil, gotErr)
+			}
+			if tc.expectErr && len(tc.expectErrStr) == 0 {
+				t.Error("invalid test setup; error message must not be empty for error cases")
+			}
+			if tc.expectErr && !strings.Contains(gotErr.Error(), tc.expectErrStr) {
+				t.Errorf("expected error message contains %q, got %v", tc.expectErrStr, gotErr)
+			}
+			if !tc.expectServiceCreate && servicesCreated != 0 {
+				t.Errorf("expected no service to be created, got %d created services", servicesCreated)
+			}
+			if tc.expectServiceCreate && servicesCreated != 1 {
+				t.Errorf("expected 1 service to be created, got %d created services", servicesCreated)
+			}
+		})
+	}
+}
+
+func TestReconcileExistingHeadlessSvc(t *testing.T) {
+	var (
+		jobSetName = "test-jobset"
+		ns         = "default"
+		jobSetUID  = "test-jobset-uid"
+	)
+
+	jobSetOwnerRef := metav1.OwnerReference{
+		APIVersion:         "jobset.x-k8s.io/v1alpha2",
+		Kind:               "JobSet",
+		Name:               jobSetName,
+		UID:                types.UID(jobSetUID),
+		Controller:         ptr.To(true),
+		BlockOwnerDeletion: ptr.To(true),
+	}
+	makeService := func(ownerRefs []metav1.OwnerReference, clusterIP string, selector map[string]string, publishNotReadyAddresses bool) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            jobSetName,
+				Namespace:       ns,
+				OwnerReferences: ownerRefs,
+			},
+			Spec: corev1.ServiceSpec{
+				ClusterIP:                clusterIP,
+				Selector:                 selector,
+				PublishNotReadyAddresses: publishNotReadyAddresses,
+			},
+		}
+	}
+	jobSetSelector := map[string]string{jobset.JobSetNameKey: jobSetName}
+
+	tests := []struct {
+		name           string
+		existing       *corev1.Service
+		wantService    *corev1.Service
+		wantConditions []metav1.Condition
+	}{
+		{
+			name:        "owned service is unchanged",
+			existing:    makeService([]metav1.OwnerReference{jobSetOwnerRef}, corev1.ClusterIPNone, jobSetSelector, true),
+			wantService: makeService([]metav1.OwnerReference{jobSetOwnerRef}, corev1.ClusterIPNone, jobSetSelector, true),
+		},
+		{
+			name:        "owned service with a drifted spec is reconciled",
+			existing:    makeService([]metav1.OwnerReference{jobSetOwnerRef}, corev1.ClusterIPNone, map[string]string{"app": "other"}, false),
+			wantService: makeService([]metav1.OwnerReference{jobSetOwnerRef}, corev1.ClusterIPNone, jobSetSelector, true),
+		},
+		{
+			name: "owned service with drifted ports is reconciled",
+			existing: func() *corev1.Service {
+				svc := makeService([]metav1.OwnerReference{jobSetOwnerRef}, corev1.ClusterIPNone, jobSetSelector, true)
+				svc.Spec.Ports = []corev1.ServicePort{{Name: "grpc", Port: 8470, TargetPort: intstr.FromInt32(8470), Protocol: corev1.ProtocolTCP}}
+				return svc
+			}(),
+			wantService: makeService([]metav1.OwnerReference{jobSetOwnerRef}, corev1.ClusterIPNone, jobSetSelector, true),
+		},
+		{
+			name:        "unowned service with a matching selector is adopted",
+			existing:    makeService(nil, corev1.ClusterIPNone, jobSetSelector, false),
+			wantService: makeService([]metav1.OwnerReference{jobSetOwnerRef}, corev1.ClusterIPNone, jobSetSelector, true),
+		},
+		{
+			name:        "unowned service with a different selector conflicts",
+			existing:    makeService(nil, corev1.ClusterIPNone, map[string]string{"app": "other"}, true),
+			wantService: makeService(nil, corev1.ClusterIPNone, map[string]string{"app": "other"}, true),
+			wantConditions: []metav1.Condition{{
+				Type:    string(jobset.JobSetServiceConflict),
+				Status:  metav1.ConditionTrue,
+				Reason:  constants.ServiceConflictReason,
+				Message: `service "test-jobset" is not owned by the jobset and its selector does not match the jobset pods`,
+			}},
+		},
+		{
+			name:        "unowned service which is not headless conflicts",
+			existing:    makeService(nil, "10.0.0.1", jobSetSelector, true),
+			wantService: makeService(nil, "10.0.0.1", jobSetSelector, true),
+			wantConditions: []metav1.Condition{{
+				Type:    string(jobset.JobSetServiceConflict),
+				Status:  metav1.ConditionTrue,
+				Reason:  constants.ServiceConflictReason,
+				Message: `service "test-jobset" is not headless`,
+			}},
+		},
+		{
+			name: "service controlled by another owner conflicts",
+			existing: makeService([]metav1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       "StatefulSet",
+				Name:       "other",
+				UID:        "other-uid",
+				Controller: ptr.To(true),
+			}}, corev1.ClusterIPNone, jobSetSelector, true),
+			wantService: makeService([]metav1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       "StatefulSet",
+				Name:       "other",
+				UID:        "other-uid",
+				Controller: ptr.To(true),
+			}}, corev1.ClusterIPNone, jobSetSelector, true),
+			wantConditions: []metav1.Condition{{
+				Type:    string(jobset.JobSetServiceConflict),
+				Status:  metav1.ConditionTrue,
+				Reason:  constants.ServiceConflictReason,
+				Message: `service "test-jobset" is controlled by StatefulSet "other"`,
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			scheme := runtime.NewScheme()
+			utilruntime.Must(jobset.AddToScheme(scheme))
+			utilruntime.Must(corev1.AddToScheme(scheme))
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tc.existing).Build()
+			r := &JobSetReconciler{
+				Client: fakeClient,
+				Scheme: scheme,
+				Record: record.NewFakeRecorder(10),
+			}
+
+			js := testutils.MakeJobSet(jobSetName, ns).EnableDNSHostnames(true).Obj()
+			js.UID = types.UID(jobSetUID)
+			if err := r.reconcileSvcs(ctx, js, &statusUpdateOpts{}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var gotService corev1.Service
+			if err := fakeClient.Get(ctx, client.ObjectKeyFromObject(tc.existing), &gotService); err != nil {
+				t.Fatalf("unexpected error getting the service: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantService.OwnerReferences, gotService.OwnerReferences); diff != "" {
+				t.Errorf("unexpected service owner references (-want/+got): %s", diff)
+			}
+			if diff := cmp.Diff(tc.wantService.Spec, gotService.Spec); diff != "" {
+				t.Errorf("unexpected service spec (-want/+got): %s", diff)
+			}
+			if diff := cmp.Diff(tc.wantConditions, js.Status.Conditions, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("unexpected conditions (-want/+got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestConstructHeadlessSvcs(t *testing.T) {
+	var (
+		jobSetName = "test-jobset"
+		ns         = "default"
+	)
+
+	makeService := func(name string, selector map[string]string, publishNotReadyAddresses bool) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: ns,
+			},
+			Spec: corev1.ServiceSpec{
+				ClusterIP:                corev1.ClusterIPNone,
+				Selector:                 selector,
+				PublishNotReadyAddresses: publishNotReadyAddresses,
+			},
+		}
+	}
+	jobSetSelector := map[string]string{jobset.JobSetNameKey: jobSetName}
+	replicatedJobSelector := func(rjobName string) map[string]string {
+		return map[string]string{jobset.JobSetNameKey: jobSetName, jobset.ReplicatedJobNameKey: rjobName}
+	}
+	withPorts := func(svc *corev1.Service, ports ...corev1.ServicePort) *corev1.Service {
+		svc.Spec.Ports = ports
+		return svc
+	}
+
+	tests := []struct {
+		name string
+		js   *jobset.JobSet
+		want []*corev1.Service
+	}{
+		{
+			name: "no network overrides",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				EnableDNSHostnames(true).
+				ReplicatedJob(testutils.MakeReplicatedJob("ps").Obj()).
+				ReplicatedJob(testutils.MakeReplicatedJob("workers").Obj()).
+				Obj(),
+			want: []*corev1.Service{
+				makeService(jobSetName, jobSetSelector, true),
+			},
+		},
+		{
+			name: "network override for some replicated jobs",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				EnableDNSHostnames(true).
+				NetworkSubdomain("default-subdomain").
+				PublishNotReadyAddresses(false).
+				ReplicatedJob(testutils.MakeReplicatedJob("driver").Obj()).
+				ReplicatedJob(testutils.MakeReplicatedJob("ps").
+					Network(&jobset.ReplicatedJobNetwork{Subdomain: "ps", PublishNotReadyAddresses: ptr.To(true)}).
+					Obj()).
+				ReplicatedJob(testutils.MakeReplicatedJob("workers").
+					Network(&jobset.ReplicatedJobNetwork{Subdomain: "workers"}).
+					Obj()).
+				Obj(),
+			want: []*corev1.Service{
+				makeService("default-subdomain", jobSetSelector, false),
+				makeService("ps", replicatedJobSelector("ps"), true),
+				makeService("workers", replicatedJobSelector("workers"), false),
+			},
+		},
+		{
+			name: "network group",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				EnableDNSHostnames(true).
+				NetworkSubdomain("shared").
+				NetworkGroup("training").
+				ReplicatedJob(testutils.MakeReplicatedJob("workers").Obj()).
+				Obj(),
+			want: []*corev1.Service{
+				makeService("shared", map[string]string{jobset.NetworkGroupKey: "training"}, true),
+			},
+		},
+		{
+			name: "network ports",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				EnableDNSHostnames(true).
+				NetworkPorts(
+					jobset.NetworkPort{Name: "grpc", Port: 8470},
+					jobset.NetworkPort{Name: "metrics", Port: 80, TargetPort: ptr.To(intstr.FromString("http-metrics")), Protocol: corev1.ProtocolUDP},
+				).
+				ReplicatedJob(testutils.MakeReplicatedJob("workers").Obj()).
+				Obj(),
+			want: []*corev1.Service{
+				withPorts(makeService(jobSetName, jobSetSelector, true),
+					corev1.ServicePort{Name: "grpc", Port: 8470, TargetPort: intstr.FromInt32(8470), Protocol: corev1.ProtocolTCP},
+					corev1.ServicePort{Name: "metrics", Port: 80, TargetPort: intstr.FromString("http-metrics"), Protocol: corev1.ProtocolUDP},
+				),
+			},
+		},
+		{
+			name: "dns hostnames disabled",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				EnableDNSHostnames(false).
+				ReplicatedJob(testutils.MakeReplicatedJob("workers").Obj()).
+				Obj(),
+		},
+		{
+			name: "network override for every replicated job",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				EnableDNSHostnames(true).
+				ReplicatedJob(testutils.MakeReplicatedJob("ps").
+					Network(&jobset.ReplicatedJobNetwork{Subdomain: "ps", PublishNotReadyAddresses: ptr.To(false)}).
+					Obj()).
+				ReplicatedJob(testutils.MakeReplicatedJob("workers").
+					Network(&jobset.ReplicatedJobNetwork{Subdomain: "workers"}).
+					Obj()).
+				Obj(),
+			want: []*corev1.Service{
+				makeService("ps", replicatedJobSelector("ps"), false),
+				makeService("workers", replicatedJobSelector("workers"), true),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, constructHeadlessSvcs(tc.js)); diff != "" {
+				t.Errorf("unexpected headless services (-want/+got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestReconcileNetworkGroupSvc(t *testing.T) {
+	var (
+		ns        = "default"
+		subdomain = "shared"
+		group     = "training"
+	)
+
+	ownerRef := func(name string) metav1.OwnerReference {
+		return metav1.OwnerReference{
+			APIVersion: "jobset.x-k8s.io/v1alpha2",
+			Kind:       "JobSet",
+			Name:       name,
+			UID:        types.UID(name + "-uid"),
+		}
+	}
+	makeJobSet := func(name string) *jobset.JobSet {
+		js := testutils.MakeJobSet(name, ns).
+			EnableDNSHostnames(true).
+			NetworkSubdomain(subdomain).
+			NetworkGroup(group).
+			ReplicatedJob(testutils.MakeReplicatedJob("workers").Obj()).
+			Obj()
+		js.UID = types.UID(name + "-uid")
+		return js
+	}
+	makeService := func(ownerRefs []metav1.OwnerReference, selector map[string]string) *corev1.Service {
+		return &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            subdomain,
+				Namespace:       ns,
+				OwnerReferences: ownerRefs,
+			},
+			Spec: corev1.ServiceSpec{
+				ClusterIP:                corev1.ClusterIPNone,
+				Selector:                 selector,
+				PublishNotReadyAddresses: true,
+			},
+		}
+	}
+	groupSelector := map[string]string{jobset.NetworkGroupKey: group}
+
+	tests := []struct {
+		name           string
+		existing       *corev1.Service
+		wantOwnerRefs  []metav1.OwnerReference
+		wantConditions []metav1.Condition
+	}{
+		{
+			name:          "service is created and owned by the jobset",
+			wantOwnerRefs: []metav1.OwnerReference{ownerRef("workers-a")},
+		},
+		{
+			name:          "service of another jobset of the group is shared",
+			existing:      makeService([]metav1.OwnerReference{ownerRef("ps")}, groupSelector),
+			wantOwnerRefs: []metav1.OwnerReference{ownerRef("ps"), ownerRef("workers-a")},
+		},
+		{
+			name:          "service already owned by the jobset is unchanged",
+			existing:      makeService([]metav1.OwnerReference{ownerRef("ps"), ownerRef("workers-a")}, groupSelector),
+			wantOwnerRefs: []metav1.OwnerReference{ownerRef("ps"), ownerRef("workers-a")},
+		},
+		{
+			name: "service controlled by a jobset outside of the group conflicts",
+			existing: makeService([]metav1.OwnerReference{{
+				APIVersion: "jobset.x-k8s.io/v1alpha2",
+				Kind:       "JobSet",
+				Name:       "other",
+				UID:        "other-uid",
+				Controller: ptr.To(true),
+			}}, map[string]string{jobset.JobSetNameKey: "other"}),
+			wantOwnerRefs: []metav1.OwnerReference{{
+				APIVersion: "jobset.x-k8s.io/v1alpha2",
+				Kind:       "JobSet",
+				Name:       "other",
+				UID:        "other-uid",
+				Controller: ptr.To(true),
+			}},
+			wantConditions: []metav1.Condition{{
+				Type:    string(jobset.JobSetServiceConflict),
+				Status:  metav1.ConditionTrue,
+				Reason:  constants.ServiceConflictReason,
+				Message: `service "shared" is controlled by JobSet "other"`,
+			}},
+		},
+		{
+			name:     "service selecting another network group conflicts",
+			existing: makeService(nil, map[string]string{jobset.NetworkGroupKey: "other"}),
+			wantConditions: []metav1.Condition{{
+				Type:    string(jobset.JobSetServiceConflict),
+				Status:  metav1.ConditionTrue,
+				Reason:  constants.ServiceConflictReason,
+				Message: `service "shared" does not select the pods of network group "training"`,
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			scheme := runtime.NewScheme()
+			utilruntime.Must(jobset.AddToScheme(scheme))
+			utilruntime.Must(corev1.AddToScheme(scheme))
+			fakeClientBuilder := fake.NewClientBuilder().WithScheme(scheme)
+			if tc.existing != nil {
+				fakeClientBuilder.WithObjects(tc.existing)
+			}
+			fakeClient := fakeClientBuilder.Build()
+			r := &JobSetReconciler{
+				Client: fakeClient,
+				Scheme: scheme,
+				Record: record.NewFakeRecorder(10),
+			}
+
+			js := makeJobSet("workers-a")
+			if err := r.reconcileSvcs(ctx, js, &statusUpdateOpts{}); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var gotService corev1.Service
+			if err := fakeClient.Get(ctx, types.NamespacedName{Name: subdomain, Namespace: ns}, &gotService); err != nil {
+				t.Fatalf("unexpected error getting the service: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantOwnerRefs, gotService.OwnerReferences); diff != "" {
+				t.Errorf("unexpected service owner references (-want/+got): %s", diff)
+			}
+			if diff := cmp.Diff(tc.wantConditions, js.Status.Conditions, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("unexpected conditions (-want/+got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	"sigs.k8s.io/jobset/pkg/constants"
+	"sigs.k8s.io/jobset/pkg/features"
+)
+
+// inPlaceRestartEnabled returns true if the InPlaceRestart feature gate is enabled
+// and the JobSet is using the InPlaceRestart restart strategy.
+func inPlaceRestartEnabled(js *jobset.JobSet) bool {
+	return features.Enabled(features.InPlaceRestart) &&
+		js.Spec.FailurePolicy != nil &&
+		js.Spec.FailurePolicy.RestartStrategy == jobset.InPlaceRestart
+}
+
+// reconcileInPlaceRestart orchestrates group restarts of the JobSet child pods by
+// updating the previous and current in-place restart attempts in the JobSet status,
+// based on the in-place restart attempts reported by the agent sidecar of each pod.
+func (r *JobSetReconciler) reconcileInPlaceRestart(ctx context.Context, js *jobset.JobSet, updateStatusOpts *statusUpdateOpts) error {
+	var podList corev1.PodList
+	if err := r.List(ctx, &podList, client.InNamespace(js.Namespace), client.MatchingFields{podJobSetKey: js.Name}); err != nil {
+		return err
+	}
+	attempts := podInPlaceRestartAttempts(ctx, js, podList.Items)
+	updateInPlaceRestartAttempts(r.clock, js, attempts, expectedPodsCount(js), updateStatusOpts)
+	return nil
+}
+
+// podInPlaceRestartAttempts returns the in-place restart attempts of the running child pods of
+// the JobSet. Pods which are terminating, finished or have not reported an attempt yet are skipped.
+func podInPlaceRestartAttempts(ctx context.Context, js *jobset.JobSet, pods []corev1.Pod) []int32 {
+	log := ctrl.LoggerFrom(ctx)
+
+	var attempts []int32
+	for _, pod := range pods {
+		if pod.Labels[jobset.JobSetUIDKey] != string(js.UID) || podDeleted(&pod) {
+			continue
+		}
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		value, ok := pod.Annotations[jobset.InPlaceRestartAttemptKey]
+		if !ok {
+			continue
+		}
+		attempt, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			log.Error(err, fmt.Sprintf("invalid value for annotation %s, must be integer", jobset.InPlaceRestartAttemptKey), "pod", pod.Name)
+			continue
+		}
+		attempts = append(attempts, int32(attempt))
+	}
+	return attempts
+}
+
+// updateInPlaceRestartAttempts updates the in-place restart attempts in the JobSet status:
+//  1. If the pods have restarted in-place more times than allowed by maxRestarts, the JobSet fails.
+//  2. If all expected pods are at the same in-place restart attempt, they are in sync, so the current
+//     in-place restart attempt is set to this value, which lifts the barrier of every pod.
+//  3. Otherwise, the previous in-place restart attempt is set to the newest attempt minus one, which
+//     restarts in-place all pods that are not at the newest attempt.
+func updateInPlaceRestartAttempts(clock clock.Clock, js *jobset.JobSet, attempts []int32, expectedPods int32, updateStatusOpts *statusUpdateOpts) {
+	if len(attempts) == 0 {
+		return
+	}
+
+	// The first attempt of a pod is the initial run, not a restart.
+	maxAttempt := slices.Max(attempts)
+	if maxAttempt-1 > js.Spec.FailurePolicy.MaxRestarts {
+		setJobSetFailedCondition(clock, js, constants.ReachedMaxRestartsReason, constants.ReachedMaxRestartsMessage, updateStatusOpts)
+		return
+	}
+
+	inSync := int32(len(attempts)) == expectedPods && slices.Min(attempts) == maxAttempt
+	if inSync {
+		if js.Status.CurrentInPlaceRestartAttempt == nil || *js.Status.CurrentInPlaceRestartAttempt != maxAttempt {
+			js.Status.CurrentInPlaceRestartAttempt = ptr.To(maxAttempt)
+			updateStatusOpts.shouldUpdate = true
+		}
+		return
+	}
+
+	if js.Status.PreviousInPlaceRestartAttempt == nil || *js.Status.PreviousInPlaceRestartAttempt != maxAttempt-1 {
+		js.Status.PreviousInPlaceRestartAttempt = ptr.To(maxAttempt - 1)
+		updateStatusOpts.shouldUpdate = true
+	}
+}
+
+// bumpInPlaceRestartAttempt starts a new in-place restart attempt of the JobSet after a child job failed,
+// by setting the previous in-place restart attempt to the newest attempt the pods may be at. This restarts
+// in-place the healthy pods which are not at a newer attempt, i.e. all of them, including the pods which
+// are already restarting for an in-place restart attempt still in progress.
+// The first attempt of a pod is 1, so the attempt is 1 if the pods were never in sync.
+func bumpInPlaceRestartAttempt(js *jobset.JobSet) {
+	attempt := max(ptr.Deref(js.Status.CurrentInPlaceRestartAttempt, 1), ptr.Deref(js.Status.PreviousInPlaceRestartAttempt, 0)+1)
+	js.Status.PreviousInPlaceRestartAttempt = ptr.To(attempt)
+}
+
+// expectedPodsCount returns the number of child pods expected to be running for the JobSet.
+func expectedPodsCount(js *jobset.JobSet) int32 {
+	var count int32
+	for _, rjob := range js.Spec.ReplicatedJobs {
+		count += rjob.Replicas * ptr.Deref(rjob.Template.Spec.Parallelism, 1)
+	}
+	return count
+}
+
+// podHasInPlaceRestartAttempt returns true if the pod has reported its in-place restart attempt.
+func podHasInPlaceRestartAttempt(obj client.Object) bool {
+	_, ok := obj.GetAnnotations()[jobset.InPlaceRestartAttemptKey]
+	return ok
+}
+
+// mapPodToJobSet maps a child pod to a reconcile request for its parent JobSet.
+func mapPodToJobSet(_ context.Context, obj client.Object) []reconcile.Request {
+	jobSetName, ok := obj.GetLabels()[jobset.JobSetNameKey]
+	if !ok {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: jobSetName, Namespace: obj.GetNamespace()}}}
+}
@@ -0,0 +1,340 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2/ktesting"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	"sigs.k8s.io/jobset/pkg/constants"
+	"sigs.k8s.io/jobset/pkg/features"
+	testutils "sigs.k8s.io/jobset/pkg/util/testing"
+)
+
+func TestPodInPlaceRestartAttempts(t *testing.T) {
+	var (
+		jobSetName = "test-jobset"
+		ns         = "default"
+		jobSetUID  = "test-uid"
+	)
+
+	childPod := func(name string) *testutils.PodWrapper {
+		return testutils.MakePod(name, ns).
+			Labels(map[string]string{jobset.JobSetUIDKey: jobSetUID}).
+			Annotations(map[string]string{})
+	}
+
+	tests := []struct {
+		name string
+		pods []corev1.Pod
+		want []int32
+	}{
+		{
+			name: "no pods",
+			want: nil,
+		},
+		{
+			name: "pods with attempts",
+			pods: []corev1.Pod{
+				childPod("pod-0").AddAnnotation(jobset.InPlaceRestartAttemptKey, "1").Obj(),
+				childPod("pod-1").AddAnnotation(jobset.InPlaceRestartAttemptKey, "2").Obj(),
+			},
+			want: []int32{1, 2},
+		},
+		{
+			name: "pods without attempt or with invalid attempt are skipped",
+			pods: []corev1.Pod{
+				childPod("pod-0").AddAnnotation(jobset.InPlaceRestartAttemptKey, "1").Obj(),
+				childPod("pod-1").Obj(),
+				childPod("pod-2").AddAnnotation(jobset.InPlaceRestartAttemptKey, "invalid").Obj(),
+			},
+			want: []int32{1},
+		},
+		{
+			name: "pods owned by a different jobset are skipped",
+			pods: []corev1.Pod{
+				childPod("pod-0").AddAnnotation(jobset.InPlaceRestartAttemptKey, "1").Obj(),
+				testutils.MakePod("pod-1", ns).
+					Labels(map[string]string{jobset.JobSetUIDKey: "other-uid"}).
+					Annotations(map[string]string{jobset.InPlaceRestartAttemptKey: "3"}).
+					Obj(),
+			},
+			want: []int32{1},
+		},
+		{
+			name: "terminating and finished pods are skipped",
+			pods: func() []corev1.Pod {
+				deleted := childPod("pod-0").AddAnnotation(jobset.InPlaceRestartAttemptKey, "1").Obj()
+				deleted.DeletionTimestamp = ptr.To(metav1.Now())
+				failed := childPod("pod-1").AddAnnotation(jobset.InPlaceRestartAttemptKey, "1").Obj()
+				failed.Status.Phase = corev1.PodFailed
+				succeeded := childPod("pod-2").AddAnnotation(jobset.InPlaceRestartAttemptKey, "1").Obj()
+				succeeded.Status.Phase = corev1.PodSucceeded
+				running := childPod("pod-3").AddAnnotation(jobset.InPlaceRestartAttemptKey, "2").Obj()
+				running.Status.Phase = corev1.PodRunning
+				return []corev1.Pod{deleted, failed, succeeded, running}
+			}(),
+			want: []int32{2},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			js := testutils.MakeJobSet(jobSetName, ns).Obj()
+			js.UID = types.UID(jobSetUID)
+			got := podInPlaceRestartAttempts(context.TODO(), js, tc.pods)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("unexpected attempts (-want/+got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateInPlaceRestartAttempts(t *testing.T) {
+	var (
+		jobSetName = "test-jobset"
+		ns         = "default"
+		now        = time.Now()
+	)
+
+	tests := []struct {
+		name             string
+		status           jobset.JobSetStatus
+		attempts         []int32
+		expectedPods     int32
+		wantStatus       jobset.JobSetStatus
+		wantShouldUpdate bool
+	}{
+		{
+			name:         "no attempts reported",
+			expectedPods: 2,
+		},
+		{
+			name:         "all pods in sync at the first attempt",
+			attempts:     []int32{1, 1},
+			expectedPods: 2,
+			wantStatus: jobset.JobSetStatus{
+				CurrentInPlaceRestartAttempt: ptr.To[int32](1),
+			},
+			wantShouldUpdate: true,
+		},
+		{
+			name:         "all pods in sync at the current attempt",
+			status:       jobset.JobSetStatus{CurrentInPlaceRestartAttempt: ptr.To[int32](1)},
+			attempts:     []int32{1, 1},
+			expectedPods: 2,
+			wantStatus: jobset.JobSetStatus{
+				CurrentInPlaceRestartAttempt: ptr.To[int32](1),
+			},
+		},
+		{
+			name:         "not all expected pods reported an attempt",
+			status:       jobset.JobSetStatus{CurrentInPlaceRestartAttempt: ptr.To[int32](1)},
+			attempts:     []int32{2},
+			expectedPods: 2,
+			wantStatus: jobset.JobSetStatus{
+				PreviousInPlaceRestartAttempt: ptr.To[int32](1),
+				CurrentInPlaceRestartAttempt:  ptr.To[int32](1),
+			},
+			wantShouldUpdate: true,
+		},
+		{
+			name:         "one pod restarted in-place",
+			status:       jobset.JobSetStatus{CurrentInPlaceRestartAttempt: ptr.To[int32](1)},
+			attempts:     []int32{1, 2},
+			expectedPods: 2,
+			wantStatus: jobset.JobSetStatus{
+				PreviousInPlaceRestartAttempt: ptr.To[int32](1),
+				CurrentInPlaceRestartAttempt:  ptr.To[int32](1),
+			},
+			wantShouldUpdate: true,
+		},
+		{
+			name: "previous attempt already updated",
+			status: jobset.JobSetStatus{
+				PreviousInPlaceRestartAttempt: ptr.To[int32](1),
+				CurrentInPlaceRestartAttempt:  ptr.To[int32](1),
+			},
+			attempts:     []int32{1, 2},
+			expectedPods: 2,
+			wantStatus: jobset.JobSetStatus{
+				PreviousInPlaceRestartAttempt: ptr.To[int32](1),
+				CurrentInPlaceRestartAttempt:  ptr.To[int32](1),
+			},
+		},
+		{
+			name: "all pods in sync after restarting in-place",
+			status: jobset.JobSetStatus{
+				PreviousInPlaceRestartAttempt: ptr.To[int32](1),
+				CurrentInPlaceRestartAttempt:  ptr.To[int32](1),
+			},
+			attempts:     []int32{2, 2},
+			expectedPods: 2,
+			wantStatus: jobset.JobSetStatus{
+				PreviousInPlaceRestartAttempt: ptr.To[int32](1),
+				CurrentInPlaceRestartAttempt:  ptr.To[int32](2),
+			},
+			wantShouldUpdate: true,
+		},
+		{
+			name:         "in-place restarts exceed max restarts",
+			status:       jobset.JobSetStatus{CurrentInPlaceRestartAttempt: ptr.To[int32](3)},
+			attempts:     []int32{3, 4},
+			expectedPods: 2,
+			wantStatus: jobset.JobSetStatus{
+				CurrentInPlaceRestartAttempt: ptr.To[int32](3),
+				TerminalState:                string(jobset.JobSetFailed),
+				CompletionTime:               ptr.To(metav1.NewTime(now)),
+				Conditions: []metav1.Condition{
+					{
+						Type:    string(jobset.JobSetFailed),
+						Status:  metav1.ConditionTrue,
+						Reason:  constants.ReachedMaxRestartsReason,
+						Message: constants.ReachedMaxRestartsMessage,
+					},
+				},
+			},
+			wantShouldUpdate: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			js := testutils.MakeJobSet(jobSetName, ns).
+				FailurePolicy(&jobset.FailurePolicy{
+					MaxRestarts:     2,
+					RestartStrategy: jobset.InPlaceRestart,
+				}).
+				SetStatus(tc.status).
+				Obj()
+			opts := &statusUpdateOpts{}
+			updateInPlaceRestartAttempts(clocktesting.NewFakeClock(now), js, tc.attempts, tc.expectedPods, opts)
+			if diff := cmp.Diff(tc.wantStatus, js.Status, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("unexpected status (-want/+got): %s", diff)
+			}
+			if opts.shouldUpdate != tc.wantShouldUpdate {
+				t.Errorf("unexpected shouldUpdate: want %v, got %v", tc.wantShouldUpdate, opts.shouldUpdate)
+			}
+		})
+	}
+}
+
+func TestExecuteFailurePolicyInPlaceRestart(t *testing.T) {
+	features.SetFeatureGateDuringTest(t, features.InPlaceRestart, true)
+
+	var (
+		jobSetName = "test-jobset"
+		ns         = "default"
+		now        = time.Now()
+	)
+	failedJob := jobWithFailedCondition("failed-job", now)
+
+	tests := []struct {
+		name       string
+		status     jobset.JobSetStatus
+		wantStatus jobset.JobSetStatus
+	}{
+		{
+			name: "pods in sync restart in-place and only the failed job is recreated",
+			status: jobset.JobSetStatus{
+				PreviousInPlaceRestartAttempt: ptr.To[int32](1),
+				CurrentInPlaceRestartAttempt:  ptr.To[int32](2),
+			},
+			wantStatus: jobset.JobSetStatus{
+				PreviousInPlaceRestartAttempt: ptr.To[int32](2),
+				CurrentInPlaceRestartAttempt:  ptr.To[int32](2),
+				Restarts:                      1,
+				RestartsCountTowardsMax:       1,
+				JobRestarts:                   []jobset.JobRestartStatus{{Name: "failed-job", Restarts: 1}},
+				RestartHistory: []jobset.RestartHistoryEntry{
+					{Attempt: 1, Time: metav1.NewTime(now), FailedJob: "failed-job", Action: jobset.RestartJobSet},
+				},
+			},
+		},
+		{
+			name: "pods never in sync restart in-place from the first attempt",
+			wantStatus: jobset.JobSetStatus{
+				PreviousInPlaceRestartAttempt: ptr.To[int32](1),
+				Restarts:                      1,
+				RestartsCountTowardsMax:       1,
+				JobRestarts:                   []jobset.JobRestartStatus{{Name: "failed-job", Restarts: 1}},
+				RestartHistory: []jobset.RestartHistoryEntry{
+					{Attempt: 1, Time: metav1.NewTime(now), FailedJob: "failed-job", Action: jobset.RestartJobSet},
+				},
+			},
+		},
+		{
+			name: "in-place restart in progress restarts the pods again",
+			status: jobset.JobSetStatus{
+				PreviousInPlaceRestartAttempt: ptr.To[int32](2),
+				CurrentInPlaceRestartAttempt:  ptr.To[int32](2),
+				Restarts:                      1,
+				RestartsCountTowardsMax:       1,
+				JobRestarts:                   []jobset.JobRestartStatus{{Name: "failed-job", Restarts: 1}},
+			},
+			wantStatus: jobset.JobSetStatus{
+				PreviousInPlaceRestartAttempt: ptr.To[int32](3),
+				CurrentInPlaceRestartAttempt:  ptr.To[int32](2),
+				Restarts:                      2,
+				RestartsCountTowardsMax:       2,
+				JobRestarts:                   []jobset.JobRestartStatus{{Name: "failed-job", Restarts: 2}},
+				RestartHistory: []jobset.RestartHistoryEntry{
+					{Attempt: 2, Time: metav1.NewTime(now), FailedJob: "failed-job", Action: jobset.RestartJobSet},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			js := testutils.MakeJobSet(jobSetName, ns).
+				FailurePolicy(&jobset.FailurePolicy{
+					MaxRestarts:     5,
+					RestartStrategy: jobset.InPlaceRestart,
+				}).
+				SetStatus(tc.status).
+				Obj()
+			opts := &statusUpdateOpts{}
+			requeueAfter, err := executeFailurePolicy(ctx, nil, clocktesting.NewFakeClock(now), js, &childJobs{failed: []*batchv1.Job{failedJob}}, opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if requeueAfter != 0 {
+				t.Errorf("unexpected requeue after %v", requeueAfter)
+			}
+			if diff := cmp.Diff(tc.wantStatus, js.Status); diff != "" {
+				t.Errorf("unexpected status (-want/+got): %s", diff)
+			}
+			if !opts.shouldUpdate {
+				t.Error("expected a status update")
+			}
+			if got := jobRestartAttempt(js, failedJob.Name); got != tc.wantStatus.JobRestarts[0].Restarts {
+				t.Errorf("unexpected restart attempt of the failed job: want %d, got %d", tc.wantStatus.JobRestarts[0].Restarts, got)
+			}
+		})
+	}
+}
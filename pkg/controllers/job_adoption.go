@@ -0,0 +1,122 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	"sigs.k8s.io/jobset/pkg/constants"
+)
+
+// jobNameConflictError is returned when a child Job cannot be created because a Job with
+// the same name already exists and cannot be adopted by the JobSet.
+type jobNameConflictError struct {
+	jobName string
+}
+
+func (e *jobNameConflictError) Error() string {
+	return fmt.Sprintf("job %q already exists and cannot be adopted by the jobset", e.jobName)
+}
+
+// jobPendingDeletionRequeueAfter is the delay after which a JobSet is requeued when a child Job
+// cannot be created yet because a Job with the same name is being deleted.
+const jobPendingDeletionRequeueAfter = 5 * time.Second
+
+// jobPendingDeletionError is returned when a child Job cannot be created yet because a Job with
+// the same name already exists and is being deleted.
+type jobPendingDeletionError struct {
+	jobName string
+}
+
+func (e *jobPendingDeletionError) Error() string {
+	return fmt.Sprintf("job %q already exists and is being deleted", e.jobName)
+}
+
+// identifyingJobLabels are the labels which must match for an existing Job to be adopted
+// as the child Job constructed by the JobSet with the same name.
+var identifyingJobLabels = []string{
+	jobset.JobSetNameKey,
+	jobset.ReplicatedJobNameKey,
+	jobset.JobIndexKey,
+	constants.RestartsKey,
+}
+
+// canAdoptJob returns true if the identifying labels of the existing Job match the ones of the
+// child Job constructed by the JobSet, and the existing Job is either already controlled by the
+// JobSet, e.g. when it was created by a previous reconciliation not observed by the cache yet,
+// or an orphan, i.e. it has no controller owner.
+func canAdoptJob(js *jobset.JobSet, existing, constructed *batchv1.Job) bool {
+	for _, key := range identifyingJobLabels {
+		if existing.Labels[key] != constructed.Labels[key] {
+			return false
+		}
+	}
+	if metav1.IsControlledBy(existing, js) {
+		return true
+	}
+	return metav1.GetControllerOf(existing) == nil
+}
+
+// jobPendingDeletion returns true if the existing Job is going away, so the child Job constructed by
+// the JobSet can be created once it is gone: either the existing Job is being deleted, or it is
+// controlled by a previous JobSet with the same name, which was deleted, so the Job is being garbage
+// collected.
+func jobPendingDeletion(js *jobset.JobSet, existing *batchv1.Job) bool {
+	if existing.DeletionTimestamp != nil {
+		return true
+	}
+	owner := metav1.GetControllerOf(existing)
+	return owner != nil && owner.APIVersion == apiGVStr && owner.Kind == "JobSet" && owner.Name == js.Name && owner.UID != js.UID
+}
+
+// adoptJob handles a child Job whose creation failed because a Job with the same name
+// already exists. If the existing Job is going away, a jobPendingDeletionError is returned so the
+// creation is retried later. Otherwise, the existing Job is adopted by setting the JobSet as its
+// controller owner if canAdoptJob allows it and it is not owned by the JobSet already, or a
+// jobNameConflictError is returned.
+func (r *JobSetReconciler) adoptJob(ctx context.Context, js *jobset.JobSet, constructed *batchv1.Job) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	var existing batchv1.Job
+	if err := r.Get(ctx, client.ObjectKeyFromObject(constructed), &existing); err != nil {
+		return err
+	}
+	if jobPendingDeletion(js, &existing) {
+		return &jobPendingDeletionError{jobName: constructed.Name}
+	}
+	if !canAdoptJob(js, &existing, constructed) {
+		return &jobNameConflictError{jobName: constructed.Name}
+	}
+	if metav1.IsControlledBy(&existing, js) {
+		log.V(2).Info("job already exists and is owned by the jobset", "job", klog.KObj(&existing))
+		return nil
+	}
+	if err := ctrl.SetControllerReference(js, &existing, r.Scheme); err != nil {
+		return err
+	}
+	if err := r.Update(ctx, &existing); err != nil {
+		return err
+	}
+	log.V(2).Info("successfully adopted orphaned job", "job", klog.KObj(&existing))
+	return nil
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	"sigs.k8s.io/jobset/pkg/constants"
+	testutils "sigs.k8s.io/jobset/pkg/util/testing"
+)
+
+func TestCanAdoptJob(t *testing.T) {
+	labels := map[string]string{
+		jobset.JobSetNameKey:        "test-jobset",
+		jobset.ReplicatedJobNameKey: "workers",
+		jobset.JobIndexKey:          "0",
+		constants.RestartsKey:       "0",
+	}
+	constructed := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "test-jobset-workers-0", Labels: labels}}
+	js := testutils.MakeJobSet("test-jobset", "default").Obj()
+	js.UID = "test-jobset-uid"
+	ownedByJobSet := []metav1.OwnerReference{
+		{APIVersion: "jobset.x-k8s.io/v1alpha2", Kind: "JobSet", Name: "test-jobset", UID: "test-jobset-uid", Controller: ptr.To(true)},
+	}
+
+	tests := []struct {
+		name     string
+		existing *batchv1.Job
+		want     bool
+	}{
+		{
+			name:     "orphaned job with matching labels",
+			existing: &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "test-jobset-workers-0", Labels: labels}},
+			want:     true,
+		},
+		{
+			name: "job already owned by the jobset",
+			existing: &batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+				Name:            "test-jobset-workers-0",
+				Labels:          labels,
+				OwnerReferences: ownedByJobSet,
+			}},
+			want: true,
+		},
+		{
+			name: "job owned by the jobset from a previous restart attempt",
+			existing: &batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+				Name: "test-jobset-workers-0",
+				Labels: map[string]string{
+					jobset.JobSetNameKey:        "test-jobset",
+					jobset.ReplicatedJobNameKey: "workers",
+					jobset.JobIndexKey:          "0",
+					constants.RestartsKey:       "1",
+				},
+				OwnerReferences: ownedByJobSet,
+			}},
+		},
+		{
+			name: "job owned by another controller",
+			existing: &batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+				Name:   "test-jobset-workers-0",
+				Labels: labels,
+				OwnerReferences: []metav1.OwnerReference{
+					{APIVersion: "jobset.x-k8s.io/v1alpha2", Kind: "JobSet", Name: "other", UID: "other-uid", Controller: ptr.To(true)},
+				},
+			}},
+		},
+		{
+			name:     "orphaned job without labels",
+			existing: &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "test-jobset-workers-0"}},
+		},
+		{
+			name: "orphaned job from a previous restart attempt",
+			existing: &batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+				Name: "test-jobset-workers-0",
+				Labels: map[string]string{
+					jobset.JobSetNameKey:        "test-jobset",
+					jobset.ReplicatedJobNameKey: "workers",
+					jobset.JobIndexKey:          "0",
+					constants.RestartsKey:       "1",
+				},
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := canAdoptJob(js, tc.existing, constructed); got != tc.want {
+				t.Errorf("canAdoptJob() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestJobPendingDeletion(t *testing.T) {
+	js := testutils.MakeJobSet("test-jobset", "default").Obj()
+	js.UID = "test-jobset-uid"
+	controlledBy := func(name string, uid types.UID) []metav1.OwnerReference {
+		return []metav1.OwnerReference{
+			{APIVersion: "jobset.x-k8s.io/v1alpha2", Kind: "JobSet", Name: name, UID: uid, Controller: ptr.To(true)},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		existing *batchv1.Job
+		want     bool
+	}{
+		{
+			name:     "orphaned job",
+			existing: &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "test-jobset-workers-0"}},
+		},
+		{
+			name: "orphaned job being deleted",
+			existing: &batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+				Name:              "test-jobset-workers-0",
+				DeletionTimestamp: ptr.To(metav1.Now()),
+			}},
+			want: true,
+		},
+		{
+			name: "job owned by the jobset",
+			existing: &batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+				Name:            "test-jobset-workers-0",
+				OwnerReferences: controlledBy("test-jobset", "test-jobset-uid"),
+			}},
+		},
+		{
+			name: "job owned by a previous jobset with the same name",
+			existing: &batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+				Name:            "test-jobset-workers-0",
+				OwnerReferences: controlledBy("test-jobset", "previous-uid"),
+			}},
+			want: true,
+		},
+		{
+			name: "job owned by another jobset",
+			existing: &batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+				Name:            "test-jobset-workers-0",
+				OwnerReferences: controlledBy("other", "other-uid"),
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := jobPendingDeletion(js, tc.existing); got != tc.want {
+				t.Errorf("jobPendingDeletion() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
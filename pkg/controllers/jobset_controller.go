@@ -29,17 +29,20 @@ import (
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 	"sigs.k8s.io/jobset/pkg/constants"
@@ -75,6 +78,9 @@ type childJobs struct {
 type statusUpdateOpts struct {
 	shouldUpdate bool
 	events       []*eventParams
+	// shouldSuspend tracks if the JobSet spec should be suspended before the status update,
+	// e.g. when the SuspendJobSet failure policy action is applied.
+	shouldSuspend bool
 }
 
 // eventParams contains parameters used for emitting a Kubernetes event.
@@ -100,6 +106,7 @@ func NewJobSetReconciler(client client.Client, scheme *runtime.Scheme, record re
 //+kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=batch,resources=jobs/status,verbs=get;patch;update
 //+kubebuilder:rbac:groups=core,resources=services,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -125,6 +132,12 @@ func (r *JobSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return result, err
 	}
 
+	if updateStatusOpts.shouldSuspend {
+		if err := r.suspendJobSet(ctx, &js); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
 	if err := r.updateJobSetStatus(ctx, &js, &updateStatusOpts); apierrors.IsConflict(err) {
 		return ctrl.Result{Requeue: true}, nil
 	}
@@ -147,6 +160,15 @@ func (r *JobSetReconciler) reconcile(ctx context.Context, js *jobset.JobSet, upd
 
 	log.V(2).Info("Reconciling JobSet")
 
+	// Apply the replicas set through the scale subresource, if any.
+	if !jobSetFinished(js) {
+		if err := r.syncScaleReplicas(ctx, js); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	updateObservedGeneration(js, updateStatusOpts)
+
 	// Get Jobs owned by JobSet.
 	ownedJobs, err := r.getChildJobs(ctx, js)
 	if err != nil {
@@ -157,6 +179,8 @@ func (r *JobSetReconciler) reconcile(ctx context.Context, js *jobset.JobSet, upd
 	// Calculate JobsReady and update statuses for each ReplicatedJob.
 	rjobStatuses := r.calculateReplicatedJobStatuses(ctx, js, ownedJobs)
 	updateReplicatedJobsStatuses(js, rjobStatuses, updateStatusOpts)
+	updateScaleStatus(js, ownedJobs, updateStatusOpts)
+	updateReadyAndProgressingConditions(js, rjobStatuses, ownedJobs, updateStatusOpts)
 
 	// If JobSet is already completed or failed, clean up active child jobs and requeue if TTLSecondsAfterFinished is set.
 	if jobSetFinished(js) {
@@ -181,34 +205,81 @@ func (r *JobSetReconciler) reconcile(ctx context.Context, js *jobset.JobSet, upd
 		return ctrl.Result{}, err
 	}
 
+	// Fail the JobSet if it has been active for longer than its active deadline.
+	// The active jobs are deleted in the next reconciliation once the JobSet is failed.
+	updateActiveTime(r.clock, js, updateStatusOpts)
+	deadlineRequeueAfter, deadlineExceeded := executeActiveDeadlinePolicy(ctx, r.clock, js, updateStatusOpts)
+	if deadlineExceeded {
+		return ctrl.Result{}, nil
+	}
+
+	// Forgive the restarts which no longer count towards maxRestarts. The JobSet is requeued
+	// when its restarts are due to be reset after it has been healthy for long enough.
+	resetRequeueAfter := executeRestartWindow(ctx, r.clock, js, updateStatusOpts)
+
+	// Defer the terminal state of the JobSet while cleanup replicatedJobs are running, so they can finish.
+	cleanupPending := cleanupReplicatedJobsPending(js, rjobStatuses)
+	if cleanupPending {
+		log.V(2).Info("deferring failure and success policies until cleanup replicated jobs finish")
+	}
+
 	// If any jobs have failed, execute the JobSet failure policy (if any).
-	if len(ownedJobs.failed) > 0 {
-		if err := executeFailurePolicy(ctx, js, ownedJobs, updateStatusOpts); err != nil {
+	if len(ownedJobs.failed) > 0 && !cleanupPending {
+		requeueAfter, err := executeFailurePolicy(ctx, r.Client, r.clock, js, ownedJobs, updateStatusOpts)
+		if err != nil {
 			log.Error(err, "executing failure policy")
 			return ctrl.Result{}, err
 		}
-		return ctrl.Result{}, nil
+		// If the JobSet is held by the failure policy, suspend the active jobs until it is resumed.
+		if jobSetHeld(js) {
+			if err := r.suspendJobs(ctx, js, ownedJobs.active, updateStatusOpts); err != nil {
+				log.Error(err, "suspending jobset")
+				return ctrl.Result{}, err
+			}
+		}
+		// Requeue at the end of the restart backoff, or at the active deadline if it comes first.
+		return ctrl.Result{RequeueAfter: shortestRequeueAfter(requeueAfter, deadlineRequeueAfter)}, nil
 	}
 
 	// If any jobs have succeeded, execute the JobSet success policy.
-	if len(ownedJobs.successful) > 0 {
-		if completed := executeSuccessPolicy(js, ownedJobs, updateStatusOpts); completed {
+	if len(ownedJobs.successful) > 0 && !cleanupPending {
+		if completed := executeSuccessPolicy(r.clock, js, ownedJobs, updateStatusOpts); completed {
 			return ctrl.Result{}, nil
 		}
 	}
 
-	// If pod DNS hostnames are enabled, create a headless service for the JobSet
-	if err := r.createHeadlessSvcIfNecessary(ctx, js); err != nil {
-		log.Error(err, "creating headless service")
+	// Create or reconcile the headless services of the JobSet, if pod DNS hostnames are enabled,
+	// and the service of the coordinator pod, if enabled.
+	if err := r.reconcileSvcs(ctx, js, updateStatusOpts); err != nil {
+		log.Error(err, "reconciling services")
 		return ctrl.Result{}, err
 	}
 
-	// If job has not failed or succeeded, reconcile the state of the replicatedJobs.
-	if err := r.reconcileReplicatedJobs(ctx, js, ownedJobs, rjobStatuses, updateStatusOpts); err != nil {
-		log.Error(err, "creating jobs")
+	// If network isolation is enabled, create or reconcile the network policy of the JobSet.
+	networkPolicyEnforced, err := r.reconcileNetworkPolicy(ctx, js, updateStatusOpts)
+	if err != nil {
+		log.Error(err, "reconciling network policy")
 		return ctrl.Result{}, err
 	}
 
+	// If job has not failed or succeeded, reconcile the state of the replicatedJobs.
+	// The child jobs are not created while the network policy isolating their pods cannot be enforced.
+	requeueAfter := shortestRequeueAfter(deadlineRequeueAfter, resetRequeueAfter)
+	if networkPolicyEnforced {
+		if err := r.reconcileReplicatedJobs(ctx, js, ownedJobs, rjobStatuses, updateStatusOpts); err != nil {
+			var pendingErr *jobPendingDeletionError
+			if !errors.As(err, &pendingErr) {
+				log.Error(err, "creating jobs")
+				return ctrl.Result{}, err
+			}
+			log.V(2).Info("job with the name of a child job is being deleted, waiting to create it", "job", pendingErr.jobName)
+			requeueAfter = shortestRequeueAfter(requeueAfter, jobPendingDeletionRequeueAfter)
+		}
+	} else {
+		log.V(2).Info("network policy conflict, not creating jobs")
+		requeueAfter = shortestRequeueAfter(requeueAfter, networkPolicyConflictRequeueAfter)
+	}
+
 	// Handle suspending a jobset or resuming a suspended jobset.
 	jobsetSuspended := jobSetSuspended(js)
 	if jobsetSuspended {
@@ -221,8 +292,15 @@ func (r *JobSetReconciler) reconcile(ctx context.Context, js *jobset.JobSet, upd
 			log.Error(err, "resuming jobset")
 			return ctrl.Result{}, err
 		}
+		// If the JobSet uses in-place restarts, orchestrate the group restart of its pods.
+		if inPlaceRestartEnabled(js) {
+			if err := r.reconcileInPlaceRestart(ctx, js, updateStatusOpts); err != nil {
+				log.Error(err, "reconciling in-place restart")
+				return ctrl.Result{}, err
+			}
+		}
 	}
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -231,6 +309,11 @@ func (r *JobSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		For(&jobset.JobSet{}).
 		Owns(&batchv1.Job{}).
 		Owns(&corev1.Service{}).
+		Owns(&networkingv1.NetworkPolicy{}).
+		// Watch child pods reporting their in-place restart attempt, so group restarts
+		// can be orchestrated when the InPlaceRestart restart strategy is used.
+		Watches(&corev1.Pod{}, handler.EnqueueRequestsFromMapFunc(mapPodToJobSet),
+			builder.WithPredicates(predicate.NewPredicateFuncs(podHasInPlaceRestartAttempt))).
 		Complete(r)
 }
 
@@ -270,6 +353,22 @@ func (r *JobSetReconciler) updateJobSetStatus(ctx context.Context, js *jobset.Jo
 	return nil
 }
 
+// suspendJobSet sets spec.suspend to true on the JobSet. The resource version of the JobSet is updated
+// so the status update performed later in the same reconciliation attempt does not conflict.
+func (r *JobSetReconciler) suspendJobSet(ctx context.Context, js *jobset.JobSet) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	suspended := js.DeepCopy()
+	suspended.Spec.Suspend = ptr.To(true)
+	if err := r.Patch(ctx, suspended, client.MergeFrom(js)); err != nil {
+		log.Error(err, "suspending jobset")
+		return err
+	}
+	js.Spec.Suspend = suspended.Spec.Suspend
+	js.ResourceVersion = suspended.ResourceVersion
+	return nil
+}
+
 // getChildJobs gets jobs owned by the JobSet then categorizes them by status (active, successful, failed).
 // Another list (`delete`) is also added which tracks jobs marked for deletion.
 func (r *JobSetReconciler) getChildJobs(ctx context.Context, js *jobset.JobSet) (*childJobs, error) {
@@ -284,21 +383,37 @@ func (r *JobSetReconciler) getChildJobs(ctx context.Context, js *jobset.JobSet)
 	// Categorize each job into a bucket: active, successful, failed, or delete.
 	ownedJobs := childJobs{}
 	for i, job := range childJobList.Items {
-		// Jobs with jobset.sigs.k8s.io/restart-attempt < restarts are marked for deletion.
+		// Jobs controlled by a previous JobSet with the same name are being garbage collected,
+		// and are not children of the JobSet.
+		if !metav1.IsControlledBy(&job, js) {
+			continue
+		}
 
+		// Jobs with jobset.sigs.k8s.io/restart-attempt < target restart attempt are marked for deletion.
+		// The target restart attempt is jobset.status.restarts, or the restarts of the job in
+		// jobset.status.jobRestarts when the RecreateFailedJobs restart strategy is used.
 		jobRestarts, err := strconv.Atoi(job.Labels[constants.RestartsKey])
 		if err != nil {
 			log.Error(err, fmt.Sprintf("invalid value for label %s, must be integer", constants.RestartsKey))
 			ownedJobs.previous = append(ownedJobs.previous, &childJobList.Items[i])
 			return nil, err
 		}
-		if int32(jobRestarts) < js.Status.Restarts {
-			log.V(2).Info("child Job marked for recreation as value of restarts label is less than target", "name", job.Name, constants.RestartsKey, jobRestarts, "target", js.Status.Restarts)
+		targetRestarts := jobRestartAttempt(js, job.Name)
+		if int32(jobRestarts) < targetRestarts {
+			log.V(2).Info("child Job marked for recreation as value of restarts label is less than target", "name", job.Name, constants.RestartsKey, jobRestarts, "target", targetRestarts)
 			ownedJobs.previous = append(ownedJobs.previous, &childJobList.Items[i])
 			continue
 		}
 
-		// Jobs with jobset.sigs.k8s.io/restart-attempt == jobset.status.restarts are part of
+		// Jobs with an index out of the replicas of their ReplicatedJob were removed by a scale down,
+		// and are marked for deletion.
+		if jobScaledDown(js, &job) {
+			log.V(2).Info("child Job marked for deletion as its ReplicatedJob was scaled down", "name", job.Name)
+			ownedJobs.previous = append(ownedJobs.previous, &childJobList.Items[i])
+			continue
+		}
+
+		// Jobs with jobset.sigs.k8s.io/restart-attempt == target restart attempt are part of
 		// the current JobSet run, and marked either active, successful, or failed.
 		_, finishedType := JobFinished(&job)
 		switch finishedType {
@@ -314,13 +429,23 @@ func (r *JobSetReconciler) getChildJobs(ctx context.Context, js *jobset.JobSet)
 }
 
 // updateReplicatedJobsStatuses updates the replicatedJob statuses if they have changed.
+// The pods of all the replicatedJobs are also aggregated into the pods of the JobSet.
 func updateReplicatedJobsStatuses(js *jobset.JobSet, statuses []jobset.ReplicatedJobStatus, updateStatusOpts *statusUpdateOpts) {
+	var pods jobset.PodsStatus
+	for _, status := range statuses {
+		pods.Active += status.Pods.Active
+		pods.NotReady += status.Pods.NotReady
+		pods.Ready += status.Pods.Ready
+		pods.Succeeded += status.Pods.Succeeded
+		pods.Failed += status.Pods.Failed
+	}
 	// If replicated job statuses haven't changed, there's nothing to do here.
-	if replicatedJobStatusesEqual(js.Status.ReplicatedJobsStatus, statuses) {
+	if replicatedJobStatusesEqual(js.Status.ReplicatedJobsStatus, statuses) && js.Status.Pods == pods {
 		return
 	}
 	// Add a new status update to perform at the end of the reconciliation attempt.
 	js.Status.ReplicatedJobsStatus = statuses
+	js.Status.Pods = pods
 	updateStatusOpts.shouldUpdate = true
 }
 
@@ -331,7 +456,11 @@ func (r *JobSetReconciler) calculateReplicatedJobStatuses(ctx context.Context, j
 
 	// Prepare replicatedJobsReady for optimal iteration
 	replicatedJobsReady := map[string]map[string]int32{}
+	replicatedJobsSuspended := map[string]bool{}
+	replicatedJobsPods := map[string]*jobset.PodsStatus{}
 	for _, replicatedJob := range js.Spec.ReplicatedJobs {
+		replicatedJobsSuspended[replicatedJob.Name] = replicatedJobSuspended(&replicatedJob)
+		replicatedJobsPods[replicatedJob.Name] = &jobset.PodsStatus{}
 		replicatedJobsReady[replicatedJob.Name] = map[string]int32{
 			"ready":     0,
 			"succeeded": 0,
@@ -364,6 +493,15 @@ func (r *JobSetReconciler) calculateReplicatedJobStatuses(ctx context.Context, j
 		}
 	}
 
+	// Aggregate the pods of the current jobs, whether they are active or finished.
+	for _, jobList := range [][]*batchv1.Job{jobs.active, jobs.successful, jobs.failed} {
+		for _, job := range jobList {
+			if pods, ok := replicatedJobsPods[job.Labels[jobset.ReplicatedJobNameKey]]; ok {
+				addJobPods(pods, job)
+			}
+		}
+	}
+
 	// Calculate succeededJobs
 	for _, job := range jobs.successful {
 		replicatedJobsReady[job.Labels[jobset.ReplicatedJobNameKey]]["succeeded"]++
@@ -376,18 +514,37 @@ func (r *JobSetReconciler) calculateReplicatedJobStatuses(ctx context.Context, j
 	// Calculate ReplicatedJobsStatus
 	var rjStatus []jobset.ReplicatedJobStatus
 	for name, status := range replicatedJobsReady {
-		rjStatus = append(rjStatus, jobset.ReplicatedJobStatus{
+		newStatus := jobset.ReplicatedJobStatus{
 			Name:      name,
 			Ready:     status["ready"],
 			Succeeded: status["succeeded"],
 			Failed:    status["failed"],
 			Active:    status["active"],
 			Suspended: status["suspended"],
-		})
+			Suspend:   replicatedJobsSuspended[name],
+			Pods:      *replicatedJobsPods[name],
+		}
+		// Restart counters are not derived from the child jobs, so they are carried over.
+		if oldStatus := findReplicatedJobStatus(js.Status.ReplicatedJobsStatus, name); oldStatus != nil {
+			newStatus.Restarts = oldStatus.Restarts
+			newStatus.RestartsCountTowardsMax = oldStatus.RestartsCountTowardsMax
+			newStatus.RestartTimes = oldStatus.RestartTimes
+		}
+		rjStatus = append(rjStatus, newStatus)
 	}
 	return rjStatus
 }
 
+// addJobPods adds the pods reported in the status of the Job to the given pod counts.
+func addJobPods(pods *jobset.PodsStatus, job *batchv1.Job) {
+	ready := ptr.Deref(job.Status.Ready, 0)
+	pods.Active += job.Status.Active
+	pods.NotReady += max(job.Status.Active-ready, 0)
+	pods.Ready += ready
+	pods.Succeeded += job.Status.Succeeded
+	pods.Failed += job.Status.Failed
+}
+
 func (r *JobSetReconciler) suspendJobs(ctx context.Context, js *jobset.JobSet, activeJobs []*batchv1.Job, updateStatusOpts *statusUpdateOpts) error {
 	for _, job := range activeJobs {
 		if !jobSuspended(job) {
@@ -419,18 +576,30 @@ func (r *JobSetReconciler) resumeJobsIfNecessary(ctx context.Context, js *jobset
 
 	startupPolicy := js.Spec.StartupPolicy
 
-	// Map where key is the Job name and value is the Job replicas.
-	rJobsReplicas := map[string]int32{}
+	// ReplicatedJobs are evaluated in topological order of their DependsOn graph.
+	orderedReplicatedJobs, dependenciesReached := evaluateDependencies(js, replicatedJobStatuses)
 
 	// If JobSpec is unsuspended, ensure all active child Jobs are also
 	// unsuspended and update the suspend condition to false.
-	for _, replicatedJob := range js.Spec.ReplicatedJobs {
+	for _, replicatedJob := range orderedReplicatedJobs {
 		replicatedJobStatus := findReplicatedJobStatus(replicatedJobStatuses, replicatedJob.Name)
 
-		rJobsReplicas[replicatedJob.Name] = replicatedJob.Replicas
+		// Suspended ReplicatedJobs are kept suspended while the other ReplicatedJobs are running.
+		if replicatedJobSuspended(&replicatedJob) {
+			for _, job := range replicatedJobToActiveJobs[replicatedJob.Name] {
+				if jobSuspended(job) {
+					continue
+				}
+				job.Spec.Suspend = ptr.To(true)
+				if err := r.Update(ctx, job); err != nil {
+					return err
+				}
+			}
+			continue
+		}
 
-		// For depends on, the ReplicatedJob is created only after the previous ReplicatedJob reached the status.
-		if !dependencyReachedStatus(replicatedJob, rJobsReplicas, replicatedJobStatuses) {
+		// For depends on, the ReplicatedJob is resumed only after its dependencies reached their status.
+		if !dependenciesReached.Has(replicatedJob.Name) {
 			continue
 		}
 
@@ -514,17 +683,15 @@ func (r *JobSetReconciler) reconcileReplicatedJobs(ctx context.Context, js *jobs
 	log := ctrl.LoggerFrom(ctx)
 	startupPolicy := js.Spec.StartupPolicy
 
-	// Map where key is the Job name and value is the Job replicas.
-	rJobsReplicas := map[string]int32{}
+	// ReplicatedJobs are evaluated in topological order of their DependsOn graph.
+	orderedReplicatedJobs, dependenciesReached := evaluateDependencies(js, replicatedJobStatuses)
 
-	for _, replicatedJob := range js.Spec.ReplicatedJobs {
+	for _, replicatedJob := range orderedReplicatedJobs {
 		jobs := constructJobsFromTemplate(js, &replicatedJob, ownedJobs)
 		replicatedJobStatus := findReplicatedJobStatus(replicatedJobStatuses, replicatedJob.Name)
 
-		rJobsReplicas[replicatedJob.Name] = replicatedJob.Replicas
-
-		// For depends on, the ReplicatedJob is created only after the previous ReplicatedJob reached the status.
-		if !dependencyReachedStatus(replicatedJob, rJobsReplicas, replicatedJobStatuses) {
+		// For depends on, the ReplicatedJob is created only after its dependencies reached their status.
+		if !dependenciesReached.Has(replicatedJob.Name) {
 			continue
 		}
 
@@ -536,6 +703,18 @@ func (r *JobSetReconciler) reconcileReplicatedJobs(ctx context.Context, js *jobs
 
 		// Create jobs as necessary.
 		if err := r.createJobs(ctx, js, jobs); err != nil {
+			// Retrying cannot resolve a job name conflict, so the JobSet is failed.
+			var conflictErr *jobNameConflictError
+			if errors.As(err, &conflictErr) {
+				log.V(2).Info("child job name conflict, failing jobset", "job", conflictErr.jobName)
+				setJobSetFailedCondition(r.clock, js, constants.JobNameConflictReason, conflictErr.Error(), updateStatusOpts)
+				return nil
+			}
+			// Jobs with the name of child jobs which are being deleted are waited for by the caller.
+			var pendingErr *jobPendingDeletionError
+			if errors.As(err, &pendingErr) {
+				return err
+			}
 			log.Error(err, "creating jobs")
 			r.Record.Eventf(js, corev1.EventTypeWarning, constants.JobCreationFailedReason, err.Error())
 			return err
@@ -544,7 +723,8 @@ func (r *JobSetReconciler) reconcileReplicatedJobs(ctx context.Context, js *jobs
 		// If we are using inOrder StartupPolicy, then we return to wait for jobs to be ready.
 		// This updates the StartupPolicy condition and notifies that we are waiting
 		// for this replicated job to start up before moving onto the next one.
-		if !jobSetSuspended(js) && inOrderStartupPolicy(startupPolicy) {
+		// Suspended replicated jobs are not waited for, since their jobs won't become ready.
+		if !jobSetSuspended(js) && inOrderStartupPolicy(startupPolicy) && !replicatedJobSuspended(&replicatedJob) {
 			setInOrderStartupPolicyInProgressCondition(js, updateStatusOpts)
 			return nil
 		}
@@ -573,12 +753,19 @@ func (r *JobSetReconciler) createJobs(ctx context.Context, js *jobset.JobSet, jo
 			return
 		}
 
-		// Create the job.
-		// TODO(#18): Deal with the case where the job exists but is not owned by the jobset.
-		if err := r.Create(ctx, job); err != nil {
+		// Create the job. If a job with the same name already exists, adopt it if it is an orphan
+		// matching the job constructed by the jobset.
+		err := r.Create(ctx, job)
+		if apierrors.IsAlreadyExists(err) {
+			err = r.adoptJob(ctx, js, job)
+			if err == nil {
+				return
+			}
+		}
+		if err != nil {
 			lock.Lock()
 			defer lock.Unlock()
-			finalErrs = append(finalErrs, fmt.Errorf("job %q creation failed with error: %v", job.Name, err))
+			finalErrs = append(finalErrs, fmt.Errorf("job %q creation failed with error: %w", job.Name, err))
 			return
 		}
 		log.V(2).Info("successfully created job", "job", klog.KObj(job))
@@ -612,61 +799,12 @@ func (r *JobSetReconciler) deleteJobs(ctx context.Context, jobsForDeletion []*ba
 	return errors.Join(finalErrs...)
 }
 
-// TODO: look into adopting service and updating the selector
-// if it is not matching the job selector.
-func (r *JobSetReconciler) createHeadlessSvcIfNecessary(ctx context.Context, js *jobset.JobSet) error {
-	log := ctrl.LoggerFrom(ctx)
-
-	// Headless service is only necessary for indexed jobs whose pods need to communicate with
-	// eachother via pod hostnames.
-	if !dnsHostnamesEnabled(js) {
-		return nil
-	}
-
-	// Check if service already exists. The service name should match the subdomain specified in
-	// Spec.Network.Subdomain, with default of <jobSetName> set by the webhook.
-	// If the service doesn't exist in the same namespace, create it.
-	var headlessSvc corev1.Service
-	subdomain := GetSubdomain(js)
-	if err := r.Get(ctx, types.NamespacedName{Name: subdomain, Namespace: js.Namespace}, &headlessSvc); err != nil {
-		if !apierrors.IsNotFound(err) {
-			return err
-		}
-		headlessSvc := corev1.Service{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      subdomain,
-				Namespace: js.Namespace,
-			},
-			Spec: corev1.ServiceSpec{
-				ClusterIP: "None",
-				Selector: map[string]string{
-					jobset.JobSetNameKey: js.Name,
-				},
-				PublishNotReadyAddresses: ptr.Deref(js.Spec.Network.PublishNotReadyAddresses, true),
-			},
-		}
-
-		// Set controller owner reference for garbage collection and reconcilation.
-		if err := ctrl.SetControllerReference(js, &headlessSvc, r.Scheme); err != nil {
-			return err
-		}
-
-		// Create headless service.
-		if err := r.Create(ctx, &headlessSvc); err != nil {
-			r.Record.Eventf(js, corev1.EventTypeWarning, constants.HeadlessServiceCreationFailedReason, err.Error())
-			return err
-		}
-		log.V(2).Info("successfully created headless service", "service", klog.KObj(&headlessSvc))
-	}
-	return nil
-}
-
 // executeSuccessPolicy checks the completed jobs against the jobset success policy
 // and updates the jobset status to completed if the success policy conditions are met.
 // Returns a boolean value indicating if the jobset was completed or not.
-func executeSuccessPolicy(js *jobset.JobSet, ownedJobs *childJobs, updateStatusOpts *statusUpdateOpts) bool {
+func executeSuccessPolicy(clock clock.Clock, js *jobset.JobSet, ownedJobs *childJobs, updateStatusOpts *statusUpdateOpts) bool {
 	if numJobsMatchingSuccessPolicy(js, ownedJobs.successful) >= numJobsExpectedToSucceed(js) {
-		setJobSetCompletedCondition(js, updateStatusOpts)
+		setJobSetCompletedCondition(clock, js, updateStatusOpts)
 		return true
 	}
 	return false
@@ -676,6 +814,8 @@ func constructJobsFromTemplate(js *jobset.JobSet, rjob *jobset.ReplicatedJob, ow
 	var jobs []*batchv1.Job
 	// If the JobSet is using the BlockingRecreate failure policy, we should not create any new jobs until
 	// all the jobs slated for deletion (i.e. from the last restart index) have been deleted.
+	// With the RecreateFailedJobs restart strategy, a failed job is recreated at the same index once
+	// it has been deleted, since shouldCreateJob skips jobs which still exist.
 	useBlockingRecreate := js.Spec.FailurePolicy != nil && js.Spec.FailurePolicy.RestartStrategy == jobset.BlockingRecreate
 	if len(ownedJobs.previous) > 0 && useBlockingRecreate {
 		return jobs
@@ -709,7 +849,21 @@ func constructJob(js *jobset.JobSet, rjob *jobset.ReplicatedJob, jobIdx int) *ba
 	// If enableDNSHostnames is set, update job spec to set subdomain as
 	// job name (a headless service with same name as job will be created later).
 	if dnsHostnamesEnabled(js) {
-		job.Spec.Template.Spec.Subdomain = GetSubdomain(js)
+		job.Spec.Template.Spec.Subdomain = replicatedJobSubdomain(js, rjob)
+	}
+
+	// If enabled, inject the topology of the JobSet as environment variables into the containers.
+	if topologyEnvEnabled(js, rjob) {
+		InjectEnv(&job.Spec.Template.Spec, topologyEnv(js, job.Spec.Template.Labels))
+	}
+
+	// If a framework preset is used, annotate the pods so their rendezvous environment variables,
+	// which depend on their completion index, are injected by the pod mutating webhook. The replicas
+	// of the world are recorded at creation time, so the pods of the Job keep the same world size
+	// after the JobSet is scaled.
+	if framework := frameworkFor(js, rjob); framework != nil {
+		job.Spec.Template.Annotations[jobset.FrameworkKey] = string(framework.Name)
+		job.Spec.Template.Annotations[jobset.FrameworkReplicasKey] = frameworkReplicas(js, framework)
 	}
 
 	// If this job is using the nodeSelectorStrategy implementation of exclusive placement,
@@ -722,9 +876,8 @@ func constructJob(js *jobset.JobSet, rjob *jobset.ReplicatedJob, jobIdx int) *ba
 		addTaintToleration(job)
 	}
 
-	// if Suspend is set, then we assume all jobs will be suspended also.
-	jobsetSuspended := jobSetSuspended(js)
-	job.Spec.Suspend = ptr.To(jobsetSuspended)
+	// if Suspend is set on the JobSet or on the ReplicatedJob, then we assume all jobs will be suspended also.
+	job.Spec.Suspend = ptr.To(jobSetSuspended(js) || replicatedJobSuspended(rjob))
 
 	return job
 }
@@ -772,7 +925,7 @@ func labelAndAnnotateObject(obj metav1.Object, js *jobset.JobSet, rjob *jobset.R
 	labels[jobset.JobSetNameKey] = js.Name
 	labels[jobset.JobSetUIDKey] = string(js.GetUID())
 	labels[jobset.ReplicatedJobNameKey] = rjob.Name
-	labels[constants.RestartsKey] = strconv.Itoa(int(js.Status.Restarts))
+	labels[constants.RestartsKey] = strconv.Itoa(int(jobRestartAttempt(js, jobName)))
 	labels[jobset.ReplicatedJobReplicas] = strconv.Itoa(int(rjob.Replicas))
 	labels[jobset.GlobalReplicasKey] = globalReplicas(js)
 	labels[jobset.JobIndexKey] = strconv.Itoa(jobIdx)
@@ -781,13 +934,16 @@ func labelAndAnnotateObject(obj metav1.Object, js *jobset.JobSet, rjob *jobset.R
 	labels[jobset.GroupNameKey] = rjob.GroupName
 	labels[jobset.GroupReplicasKey] = groupReplicas(js, rjob.GroupName)
 	labels[jobset.JobGroupIndexKey] = groupJobIndex(js, rjob.GroupName, rjob.Name, jobIdx)
+	if group := networkGroup(js); group != "" {
+		labels[jobset.NetworkGroupKey] = group
+	}
 
 	annotations := make(map[string]string)
 	maps.Copy(annotations, obj.GetAnnotations())
 	annotations[jobset.JobSetNameKey] = js.Name
 	annotations[jobset.JobSetUIDKey] = string(js.GetUID())
 	annotations[jobset.ReplicatedJobNameKey] = rjob.Name
-	annotations[constants.RestartsKey] = strconv.Itoa(int(js.Status.Restarts))
+	annotations[constants.RestartsKey] = strconv.Itoa(int(jobRestartAttempt(js, jobName)))
 	annotations[jobset.ReplicatedJobReplicas] = strconv.Itoa(int(rjob.Replicas))
 	annotations[jobset.GlobalReplicasKey] = globalReplicas(js)
 	annotations[jobset.JobIndexKey] = strconv.Itoa(jobIdx)
@@ -844,6 +1000,15 @@ func GetSubdomain(js *jobset.JobSet) string {
 	return js.Name
 }
 
+// replicatedJobSubdomain returns the subdomain of the pods of the given ReplicatedJob, which is
+// the subdomain of its network override if set, or the subdomain of the JobSet otherwise.
+func replicatedJobSubdomain(js *jobset.JobSet, rjob *jobset.ReplicatedJob) string {
+	if rjob != nil && rjob.Network != nil && rjob.Network.Subdomain != "" {
+		return rjob.Network.Subdomain
+	}
+	return GetSubdomain(js)
+}
+
 // addNamespacedJobNodeSelector adds the namespaced job name as a nodeSelector for use by the
 // nodeSelector exclusive job placement strategy, where the user has labeled nodes ahead of time
 // with one job name label per nodepool using hack/label_nodes/label_nodes.py
@@ -885,6 +1050,14 @@ func jobSetMarkedForDeletion(js *jobset.JobSet) bool {
 	return js.DeletionTimestamp != nil
 }
 
+// networkGroup returns the network group shared by the JobSet with other JobSets, if any.
+func networkGroup(js *jobset.JobSet) string {
+	if js.Spec.Network == nil {
+		return ""
+	}
+	return js.Spec.Network.Group
+}
+
 func dnsHostnamesEnabled(js *jobset.JobSet) bool {
 	return js.Spec.Network.EnableDNSHostnames != nil && *js.Spec.Network.EnableDNSHostnames
 }
@@ -893,6 +1066,10 @@ func jobSetSuspended(js *jobset.JobSet) bool {
 	return ptr.Deref(js.Spec.Suspend, false)
 }
 
+func replicatedJobSuspended(rjob *jobset.ReplicatedJob) bool {
+	return ptr.Deref(rjob.Suspend, false)
+}
+
 func jobSuspended(job *batchv1.Job) bool {
 	return ptr.Deref(job.Spec.Suspend, false)
 }
@@ -1002,13 +1179,27 @@ func updateCondition(js *jobset.JobSet, opts *conditionOpts) bool {
 }
 
 // setJobSetCompletedCondition sets a condition and terminal state on the JobSet status indicating it has completed.
-func setJobSetCompletedCondition(js *jobset.JobSet, updateStatusOpts *statusUpdateOpts) {
+func setJobSetCompletedCondition(clock clock.Clock, js *jobset.JobSet, updateStatusOpts *statusUpdateOpts) {
 	setCondition(js, makeCompletedConditionsOpts(), updateStatusOpts)
 	js.Status.TerminalState = string(jobset.JobSetCompleted)
+	setCompletionTime(clock, js)
 	// Update the metrics
 	metrics.JobSetCompleted(js.Name, js.Namespace)
 }
 
+// setCompletionTime sets the time the JobSet finished in its status, along with the time
+// it was active until then, if they are not set already.
+func setCompletionTime(clock clock.Clock, js *jobset.JobSet) {
+	if js.Status.CompletionTime != nil {
+		return
+	}
+	now := clock.Now()
+	js.Status.CompletionTime = ptr.To(metav1.NewTime(now))
+	if js.Status.StartTime != nil {
+		js.Status.ActiveDuration = &metav1.Duration{Duration: activeDuration(js, now)}
+	}
+}
+
 // setJobSetSuspendedCondition sets a condition on the JobSet status indicating it is currently suspended.
 func setJobSetSuspendedCondition(js *jobset.JobSet, updateStatusOpts *statusUpdateOpts) {
 	setCondition(js, makeSuspendedConditionOpts(), updateStatusOpts)
@@ -1087,7 +1278,11 @@ func exclusiveConditions(cond1, cond2 metav1.Condition) bool {
 // CoordinatorEndpoint returns the stable network endpoint where the coordinator pod can be reached.
 // This function assumes the caller has validated that jobset.Spec.Coordinator != nil.
 func CoordinatorEndpoint(js *jobset.JobSet) string {
-	return fmt.Sprintf("%s-%s-%d-%d.%s", js.Name, js.Spec.Coordinator.ReplicatedJob, js.Spec.Coordinator.JobIndex, js.Spec.Coordinator.PodIndex, GetSubdomain(js))
+	var rjob *jobset.ReplicatedJob
+	if idx := slices.IndexFunc(js.Spec.ReplicatedJobs, func(rjob jobset.ReplicatedJob) bool { return rjob.Name == js.Spec.Coordinator.ReplicatedJob }); idx >= 0 {
+		rjob = &js.Spec.ReplicatedJobs[idx]
+	}
+	return fmt.Sprintf("%s-%s-%d-%d.%s", js.Name, js.Spec.Coordinator.ReplicatedJob, js.Spec.Coordinator.JobIndex, js.Spec.Coordinator.PodIndex, replicatedJobSubdomain(js, rjob))
 }
 
 // globalJobIndex determines the job global index for a given job. The job global index is a unique
@@ -15,23 +15,11 @@ package controllers
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"strconv"
-	"strings"
 	"testing"
 	"time"
 
-	"k8s.io/client-go/tools/record"
-
-	"k8s.io/utils/ptr"
-	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
-
-	"k8s.io/apimachinery/pkg/runtime"
-	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
-	"k8s.io/klog/v2/ktesting"
-
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	batchv1 "k8s.io/api/batch/v1"
@@ -270,6 +258,42 @@ func TestConstructJobsFromTemplate(t *testing.T) {
 					Suspend(false).Obj(),
 			},
 		},
+		{
+			name: "failed job recreated at the same index with RecreateFailedJobs restart strategy",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				FailurePolicy(&jobset.FailurePolicy{
+					MaxRestarts:     3,
+					RestartStrategy: jobset.RecreateFailedJobs,
+				}).
+				ReplicatedJob(testutils.MakeReplicatedJob(replicatedJobName).
+					Job(testutils.MakeJobTemplate(jobName, ns).Obj()).
+					Replicas(2).
+					GroupName("default").
+					Obj()).
+				SetStatus(jobset.JobSetStatus{
+					Restarts: 2,
+					JobRestarts: []jobset.JobRestartStatus{
+						{Name: "test-jobset-replicated-job-1", Restarts: 2},
+					},
+				}).Obj(),
+			ownedJobs: &childJobs{
+				active: []*batchv1.Job{
+					testutils.MakeJob("test-jobset-replicated-job-0", ns).Obj(),
+				},
+			},
+			want: []*batchv1.Job{
+				makeJob(&makeJobArgs{
+					jobSetName:        jobSetName,
+					replicatedJobName: replicatedJobName,
+					groupName:         "default",
+					jobName:           "test-jobset-replicated-job-1",
+					ns:                ns,
+					replicas:          2,
+					jobIdx:            1,
+					restarts:          2}).
+					Suspend(false).Obj(),
+			},
+		},
 		{
 			name: "one job created, one job not created (already succeeded)",
 			js: testutils.MakeJobSet(jobSetName, ns).
@@ -699,6 +723,34 @@ func TestConstructJobsFromTemplate(t *testing.T) {
 					Subdomain(jobSetName).Obj(),
 			},
 		},
+		{
+			name: "suspend replicated job",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				Suspend(false).
+				EnableDNSHostnames(true).
+				NetworkSubdomain(jobSetName).
+				ReplicatedJob(testutils.MakeReplicatedJob(replicatedJobName).
+					Job(testutils.MakeJobTemplate(jobName, ns).Obj()).
+					Subdomain(jobSetName).
+					Replicas(1).
+					GroupName("default").
+					Suspend(true).
+					Obj()).
+				Obj(),
+			ownedJobs: &childJobs{},
+			want: []*batchv1.Job{
+				makeJob(&makeJobArgs{
+					jobSetName:        jobSetName,
+					replicatedJobName: replicatedJobName,
+					groupName:         "default",
+					jobName:           "test-jobset-replicated-job-0",
+					ns:                ns,
+					replicas:          1,
+					jobIdx:            0}).
+					Suspend(true).
+					Subdomain(jobSetName).Obj(),
+			},
+		},
 		{
 			name: "node selector exclusive placement strategy enabled",
 			js: testutils.MakeJobSet(jobSetName, ns).
@@ -777,6 +829,69 @@ func TestConstructJobsFromTemplate(t *testing.T) {
 					Subdomain(jobSetName).Obj(),
 			},
 		},
+		{
+			name: "coordinator with replicated job network override",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				Coordinator(&jobset.Coordinator{
+					ReplicatedJob: replicatedJobName,
+					JobIndex:      0,
+					PodIndex:      0,
+				}).
+				EnableDNSHostnames(true).
+				NetworkSubdomain(jobSetName).
+				ReplicatedJob(testutils.MakeReplicatedJob(replicatedJobName).
+					Job(testutils.MakeJobTemplate(jobName, ns).Obj()).
+					Network(&jobset.ReplicatedJobNetwork{Subdomain: "coordinator"}).
+					Replicas(1).
+					GroupName("default").
+					Obj()).
+				Obj(),
+			ownedJobs: &childJobs{},
+			want: []*batchv1.Job{
+				makeJob(&makeJobArgs{
+					jobSetName:        jobSetName,
+					replicatedJobName: replicatedJobName,
+					groupName:         "default",
+					jobName:           "test-jobset-replicated-job-0",
+					ns:                ns,
+					replicas:          1,
+					jobIdx:            0}).
+					JobAnnotations(map[string]string{jobset.CoordinatorKey: "test-jobset-replicated-job-0-0.coordinator"}).
+					JobLabels(map[string]string{jobset.CoordinatorKey: "test-jobset-replicated-job-0-0.coordinator"}).
+					PodAnnotations(map[string]string{jobset.CoordinatorKey: "test-jobset-replicated-job-0-0.coordinator"}).
+					PodLabels(map[string]string{jobset.CoordinatorKey: "test-jobset-replicated-job-0-0.coordinator"}).
+					Suspend(false).
+					Subdomain("coordinator").Obj(),
+			},
+		},
+		{
+			name: "network group",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				EnableDNSHostnames(true).
+				NetworkSubdomain("shared").
+				NetworkGroup("training").
+				ReplicatedJob(testutils.MakeReplicatedJob(replicatedJobName).
+					Job(testutils.MakeJobTemplate(jobName, ns).Obj()).
+					Replicas(1).
+					GroupName("default").
+					Obj()).
+				Obj(),
+			ownedJobs: &childJobs{},
+			want: []*batchv1.Job{
+				makeJob(&makeJobArgs{
+					jobSetName:        jobSetName,
+					replicatedJobName: replicatedJobName,
+					groupName:         "default",
+					jobName:           "test-jobset-replicated-job-0",
+					ns:                ns,
+					replicas:          1,
+					jobIdx:            0}).
+					JobLabels(map[string]string{jobset.NetworkGroupKey: "training"}).
+					PodLabels(map[string]string{jobset.NetworkGroupKey: "training"}).
+					Suspend(false).
+					Subdomain("shared").Obj(),
+			},
+		},
 		{
 			name: "startup-policy",
 			js: testutils.MakeJobSet(jobSetName, ns).
@@ -1085,11 +1200,13 @@ func TestCalculateReplicatedJobStatuses(t *testing.T) {
 					Name:      "replicated-job-1",
 					Ready:     1,
 					Succeeded: 0,
+					Pods:      jobset.PodsStatus{Ready: 1, Succeeded: 1},
 				},
 				{
 					Name:      "replicated-job-2",
 					Ready:     3,
 					Succeeded: 0,
+					Pods:      jobset.PodsStatus{Ready: 7, Succeeded: 6},
 				},
 			},
 		},
@@ -1117,6 +1234,68 @@ func TestCalculateReplicatedJobStatuses(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "restart counters are carried over from the jobset status",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				ReplicatedJob(testutils.MakeReplicatedJob("replicated-job-1").
+					Job(testutils.MakeJobTemplate("test-job", ns).Obj()).
+					Replicas(1).
+					Obj()).
+				ReplicatedJob(testutils.MakeReplicatedJob("replicated-job-2").
+					Job(testutils.MakeJobTemplate("test-job", ns).Obj()).
+					Replicas(3).
+					Obj()).
+				SetStatus(jobset.JobSetStatus{
+					ReplicatedJobsStatus: []jobset.ReplicatedJobStatus{
+						{Name: "replicated-job-1", Failed: 1, Restarts: 2, RestartsCountTowardsMax: 1},
+					},
+				}).Obj(),
+			expected: []jobset.ReplicatedJobStatus{
+				{
+					Name:                    "replicated-job-1",
+					Restarts:                2,
+					RestartsCountTowardsMax: 1,
+				},
+				{
+					Name: "replicated-job-2",
+				},
+			},
+		},
+		{
+			name: "suspended replicated job",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				ReplicatedJob(testutils.MakeReplicatedJob("replicated-job-1").
+					Job(testutils.MakeJobTemplate("test-job", ns).Obj()).
+					Replicas(1).
+					Suspend(true).
+					Obj()).
+				ReplicatedJob(testutils.MakeReplicatedJob("replicated-job-2").
+					Job(testutils.MakeJobTemplate("test-job", ns).Obj()).
+					Replicas(1).
+					Obj()).Obj(),
+			jobs: childJobs{
+				active: []*batchv1.Job{
+					makeJob(&makeJobArgs{
+						jobSetName:        jobSetName,
+						replicatedJobName: "replicated-job-1",
+						groupName:         "default",
+						jobName:           "test-jobset-replicated-job-1-test-job-0"}).
+						Parallelism(1).
+						Suspend(true).
+						Obj(),
+				},
+			},
+			expected: []jobset.ReplicatedJobStatus{
+				{
+					Name:      "replicated-job-1",
+					Suspended: 1,
+					Suspend:   true,
+				},
+				{
+					Name: "replicated-job-2",
+				},
+			},
+		},
 		{
 			name: "partial jobs created",
 			js: testutils.MakeJobSet(jobSetName, ns).
@@ -1153,6 +1332,7 @@ func TestCalculateReplicatedJobStatuses(t *testing.T) {
 					Name:      "replicated-job-2",
 					Ready:     1,
 					Succeeded: 0,
+					Pods:      jobset.PodsStatus{Ready: 2, Succeeded: 3},
 				},
 			},
 		},
@@ -1285,11 +1465,64 @@ func TestCalculateReplicatedJobStatuses(t *testing.T) {
 					Name:   "replicated-job-1",
 					Ready:  0,
 					Active: 1,
+					Pods:   jobset.PodsStatus{Active: 1, NotReady: 1},
 				},
 				{
 					Name:   "replicated-job-2",
 					Ready:  0,
 					Active: 1,
+					Pods:   jobset.PodsStatus{Active: 1, NotReady: 1},
+				},
+			},
+		},
+		{
+			name: "pods are aggregated from active and finished jobs",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				ReplicatedJob(testutils.MakeReplicatedJob("replicated-job-1").
+					Job(testutils.MakeJobTemplate("test-job", ns).Obj()).
+					Replicas(3).
+					Obj()).Obj(),
+			jobs: childJobs{
+				active: []*batchv1.Job{
+					makeJob(&makeJobArgs{
+						jobSetName:        jobSetName,
+						replicatedJobName: "replicated-job-1",
+						groupName:         "default",
+						jobName:           "test-jobset-replicated-job-1-test-job-0"}).
+						Parallelism(8).
+						Active(8).
+						Ready(5).
+						Obj(),
+				},
+				successful: []*batchv1.Job{
+					makeJob(&makeJobArgs{
+						jobSetName:        jobSetName,
+						replicatedJobName: "replicated-job-1",
+						groupName:         "default",
+						jobName:           "test-jobset-replicated-job-1-test-job-1"}).
+						Parallelism(8).
+						Succeeded(8).
+						Obj(),
+				},
+				failed: []*batchv1.Job{
+					makeJob(&makeJobArgs{
+						jobSetName:        jobSetName,
+						replicatedJobName: "replicated-job-1",
+						groupName:         "default",
+						jobName:           "test-jobset-replicated-job-1-test-job-2"}).
+						Parallelism(8).
+						Succeeded(6).
+						Failed(2).
+						Obj(),
+				},
+			},
+			expected: []jobset.ReplicatedJobStatus{
+				{
+					Name:      "replicated-job-1",
+					Active:    1,
+					Succeeded: 1,
+					Failed:    1,
+					Pods:      jobset.PodsStatus{Active: 8, NotReady: 3, Ready: 5, Succeeded: 14, Failed: 2},
 				},
 			},
 		},
@@ -1359,6 +1592,29 @@ func TestCalculateReplicatedJobStatuses(t *testing.T) {
 	}
 }
 
+func TestUpdateReplicatedJobsStatuses(t *testing.T) {
+	js := testutils.MakeJobSet("test-jobset", "default").Obj()
+	statuses := []jobset.ReplicatedJobStatus{
+		{Name: "leader", Active: 1, Pods: jobset.PodsStatus{Active: 1, Ready: 1}},
+		{Name: "workers", Active: 2, Pods: jobset.PodsStatus{Active: 16, NotReady: 3, Ready: 13, Failed: 1}},
+	}
+	opts := &statusUpdateOpts{}
+	updateReplicatedJobsStatuses(js, statuses, opts)
+	if !opts.shouldUpdate {
+		t.Error("expected a status update")
+	}
+	wantPods := jobset.PodsStatus{Active: 17, NotReady: 3, Ready: 14, Failed: 1}
+	if diff := cmp.Diff(wantPods, js.Status.Pods); diff != "" {
+		t.Errorf("unexpected jobset pods (-want/+got): %s", diff)
+	}
+
+	opts = &statusUpdateOpts{}
+	updateReplicatedJobsStatuses(js, statuses, opts)
+	if opts.shouldUpdate {
+		t.Error("unexpected status update when the statuses are unchanged")
+	}
+}
+
 // Helper function to create a job object with a failed condition
 func jobWithFailedCondition(name string, failureTime time.Time) *batchv1.Job {
 	return jobWithFailedConditionAndOpts(name, failureTime, nil)
@@ -1467,155 +1723,6 @@ func makeJob(args *makeJobArgs) *testutils.JobWrapper {
 	return jobWrapper
 }
 
-func TestCreateHeadlessSvcIfNecessary(t *testing.T) {
-	var (
-		jobSetName = "test-jobset"
-		ns         = "default"
-	)
-
-	tests := []struct {
-		name                           string
-		jobSet                         *jobset.JobSet
-		existingService                bool
-		expectServiceCreate            bool
-		expectServiceName              string
-		expectPublishNotReadyAddresses bool
-		expectErr                      bool
-		expectErrStr                   string
-	}{
-		{
-			name:            "headless service exists and should not be created",
-			jobSet:          testutils.MakeJobSet(jobSetName, ns).EnableDNSHostnames(true).Obj(),
-			existingService: true,
-		},
-		{
-			name:            "headless service creation fails with unexpected error",
-			jobSet:          testutils.MakeJobSet(jobSetName, ns).EnableDNSHostnames(true).Obj(),
-			existingService: false,
-			expectErr:       true,
-			expectErrStr:    "unexpected error",
-		},
-		{
-			name:                           "service does not exist and should be created, subdomain not set",
-			jobSet:                         testutils.MakeJobSet(jobSetName, ns).EnableDNSHostnames(true).Obj(),
-			expectServiceCreate:            true,
-			expectServiceName:              "test-jobset",
-			expectPublishNotReadyAddresses: true,
-		},
-		{
-			name:                           "service does not exist and should be created, subdomain set",
-			jobSet:                         testutils.MakeJobSet(jobSetName, ns).EnableDNSHostnames(true).NetworkSubdomain("test-subdomain").Obj(),
-			expectServiceCreate:            true,
-			expectServiceName:              "test-subdomain",
-			expectPublishNotReadyAddresses: true,
-		},
-		{
-			name:                           "service does not exist and should be created, publishNotReadyAddresses is false",
-			jobSet:                         testutils.MakeJobSet(jobSetName, ns).EnableDNSHostnames(true).PublishNotReadyAddresses(false).Obj(),
-			expectServiceCreate:            true,
-			expectServiceName:              "test-jobset",
-			expectPublishNotReadyAddresses: false,
-		},
-		{
-			name:                           "service does not exist and should be created, publishNotReadyAddresses is true",
-			jobSet:                         testutils.MakeJobSet(jobSetName, ns).EnableDNSHostnames(true).PublishNotReadyAddresses(true).Obj(),
-			expectServiceCreate:            true,
-			expectServiceName:              "test-jobset",
-			expectPublishNotReadyAddresses: true,
-		},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.name, func(t *testing.T) {
-			var servicesCreated int
-			_, ctx := ktesting.NewTestContext(t)
-			scheme := runtime.NewScheme()
-			utilruntime.Must(jobset.AddToScheme(scheme))
-			utilruntime.Must(corev1.AddToScheme(scheme))
-			fakeClientBuilder := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
-				Create: func(ctx context.Context, client client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
-					if tc.expectErr {
-						return errors.New("unexpected error")
-					}
-					if !tc.expectServiceCreate {
-						t.Fatal("unexpected service creation")
-					}
-					svc := obj.(*corev1.Service)
-					if svc.Name != tc.expectServiceName {
-						t.Errorf("expected service name to be %q, got %q", tc.expectServiceName, svc.Name)
-					}
-					if len(svc.OwnerReferences) != 1 {
-						t.Error("expected service to have owner reference set")
-					}
-					expectedOwnerRef := metav1.OwnerReference{
-						APIVersion:         "jobset.x-k8s.io/v1alpha2",
-						Kind:               "JobSet",
-						Name:               "test-jobset",
-						Controller:         ptr.To(true),
-						BlockOwnerDeletion: ptr.To(true),
-					}
-					if diff := cmp.Diff(expectedOwnerRef, svc.OwnerReferences[0]); diff != "" {
-						t.Errorf("unexpected service owner reference value (+got/-want): %s", diff)
-					}
-					if svc.Spec.ClusterIP != corev1.ClusterIPNone {
-						t.Errorf("expected service to have ClusterIP None, got %s", svc.Spec.ClusterIP)
-					}
-					selectorValue, ok := svc.Spec.Selector[jobset.JobSetNameKey]
-					if !ok {
-						t.Errorf("expected service selector to contain %q key", jobset.JobSetNameKey)
-					}
-					if selectorValue != tc.jobSet.Name {
-						t.Errorf("expected service selector to be %q, got %q", tc.jobSet.Name, selectorValue)
-					}
-					if svc.Spec.PublishNotReadyAddresses != tc.expectPublishNotReadyAddresses {
-						t.Errorf("expected PublishNotReadyAddresses to be %t, got %t", tc.expectPublishNotReadyAddresses, svc.Spec.PublishNotReadyAddresses)
-					}
-					servicesCreated++
-					return nil
-				},
-			})
-			if tc.existingService {
-				svc := &corev1.Service{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "test-jobset",
-						Namespace: ns,
-					},
-				}
-				fakeClientBuilder.WithObjects(svc)
-			}
-			fakeClient := fakeClientBuilder.Build()
-
-			eventBroadcaster := record.NewBroadcaster()
-			recorder := eventBroadcaster.NewRecorder(scheme, corev1.EventSource{Component: "jobset-test-reconciler"})
-
-			// Create a JobSetReconciler instance with the fake client
-			r := &JobSetReconciler{
-				Client: fakeClient,
-				Scheme: scheme,
-				Record: recorder,
-			}
-
-			// Execute the function under test
-			gotErr := r.createHeadlessSvcIfNecessary(ctx, tc.jobSet)
-			if tc.expectErr != (gotErr != nil) {
-				t.Errorf("expected error is %t, got %t, error: %v", tc.expectErr, gotErr != nil, gotErr)
-			}
-			if tc.expectErr && len(tc.expectErrStr) == 0 {
-				t.Error("invalid test setup; error message must not be empty for error cases")
-			}
-			if tc.expectErr && !strings.Contains(gotErr.Error(), tc.expectErrStr) {
-				t.Errorf("expected error message contains %q, got %v", tc.expectErrStr, gotErr)
-			}
-			if !tc.expectServiceCreate && servicesCreated != 0 {
-				t.Errorf("expected no service to be created, got %d created services", servicesCreated)
-			}
-			if tc.expectServiceCreate && servicesCreated != 1 {
-				t.Errorf("expected 1 service to be created, got %d created services", servicesCreated)
-			}
-		})
-	}
-}
-
 func TestGlobalJobIndex(t *testing.T) {
 	tests := []struct {
 		name                   string
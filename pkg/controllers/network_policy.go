@@ -0,0 +1,171 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	"sigs.k8s.io/jobset/pkg/constants"
+)
+
+// networkPolicyConflictRequeueAfter is the delay after which a JobSet whose network policy conflicts
+// with an existing network policy is reconciled again. The conflicting network policy is not owned
+// by the JobSet, so its deletion does not trigger a reconciliation of the JobSet.
+const networkPolicyConflictRequeueAfter = 30 * time.Second
+
+// networkPolicyName returns the name of the network policy isolating the pods of the JobSet.
+func networkPolicyName(js *jobset.JobSet) string {
+	return js.Name + "-isolation"
+}
+
+// reconcileNetworkPolicy ensures the network policy isolating the pods of the JobSet exists and
+// matches its spec, if network isolation is enabled, and returns whether the pods of the JobSet are
+// isolated as specified. If a network policy with the same name exists and is not controlled by the
+// JobSet, it is left untouched and the NetworkPolicyConflict condition is set.
+func (r *JobSetReconciler) reconcileNetworkPolicy(ctx context.Context, js *jobset.JobSet, updateStatusOpts *statusUpdateOpts) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+
+	desired := constructNetworkPolicy(js)
+	if desired == nil {
+		return true, nil
+	}
+
+	var policy networkingv1.NetworkPolicy
+	if err := r.Get(ctx, client.ObjectKeyFromObject(desired), &policy); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, err
+		}
+		if err := ctrl.SetControllerReference(js, desired, r.Scheme); err != nil {
+			return false, err
+		}
+		if err := r.Create(ctx, desired); err != nil {
+			return false, err
+		}
+		log.V(2).Info("successfully created network policy", "networkPolicy", klog.KObj(desired))
+		setCondition(js, makeNetworkPolicyConflictConditionOpts(""), updateStatusOpts)
+		return true, nil
+	}
+
+	if !metav1.IsControlledBy(&policy, js) {
+		conflict := fmt.Sprintf("network policy %q already exists and is not controlled by the jobset", policy.Name)
+		setCondition(js, makeNetworkPolicyConflictConditionOpts(conflict), updateStatusOpts)
+		return false, nil
+	}
+	setCondition(js, makeNetworkPolicyConflictConditionOpts(""), updateStatusOpts)
+	if apiequality.Semantic.DeepEqual(policy.Spec, desired.Spec) {
+		return true, nil
+	}
+	policy.Spec = desired.Spec
+	if err := r.Update(ctx, &policy); err != nil {
+		return false, err
+	}
+	log.V(2).Info("successfully reconciled network policy", "networkPolicy", klog.KObj(&policy))
+	return true, nil
+}
+
+// makeNetworkPolicyConflictConditionOpts returns the options we use to generate the JobSet network policy
+// conflict condition. The condition is true with the given conflict as message, or false if the conflict is empty.
+func makeNetworkPolicyConflictConditionOpts(conflict string) *conditionOpts {
+	if conflict == "" {
+		return &conditionOpts{
+			eventType: corev1.EventTypeNormal,
+			condition: &metav1.Condition{
+				Type:    string(jobset.JobSetNetworkPolicyConflict),
+				Status:  metav1.ConditionFalse,
+				Reason:  constants.NetworkPolicyReconciledReason,
+				Message: constants.NetworkPolicyReconciledMessage,
+			},
+		}
+	}
+	return &conditionOpts{
+		eventType: corev1.EventTypeWarning,
+		condition: &metav1.Condition{
+			Type:    string(jobset.JobSetNetworkPolicyConflict),
+			Status:  metav1.ConditionTrue,
+			Reason:  constants.NetworkPolicyConflictReason,
+			Message: conflict,
+		},
+	}
+}
+
+// constructNetworkPolicy returns the network policy isolating the pods of the JobSet, or nil if
+// network isolation is not enabled. The pods of the JobSet only accept ingress traffic:
+//  1. From the pods of the JobSet, or of the JobSets of its network group if set.
+//  2. From the additional peers of the isolation spec, on any port.
+//  3. On the additional ports of the isolation spec, from any peer.
+func constructNetworkPolicy(js *jobset.JobSet) *networkingv1.NetworkPolicy {
+	if js.Spec.Network == nil || js.Spec.Network.Isolation == nil {
+		return nil
+	}
+	isolation := js.Spec.Network.Isolation
+
+	podSelector := metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			jobset.JobSetNameKey: js.Name,
+			jobset.JobSetUIDKey:  string(js.UID),
+		},
+	}
+	peerSelector := podSelector.DeepCopy()
+	if group := networkGroup(js); group != "" {
+		peerSelector = &metav1.LabelSelector{
+			MatchLabels: map[string]string{jobset.NetworkGroupKey: group},
+		}
+	}
+
+	ingress := []networkingv1.NetworkPolicyIngressRule{{
+		From: []networkingv1.NetworkPolicyPeer{{PodSelector: peerSelector}},
+	}}
+	if len(isolation.From) > 0 {
+		ingress = append(ingress, networkingv1.NetworkPolicyIngressRule{From: isolation.From})
+	}
+	if len(isolation.Ports) > 0 {
+		ingress = append(ingress, networkingv1.NetworkPolicyIngressRule{Ports: networkPolicyPorts(isolation.Ports)})
+	}
+
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      networkPolicyName(js),
+			Namespace: js.Namespace,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: podSelector,
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+			Ingress:     ingress,
+		},
+	}
+}
+
+// networkPolicyPorts returns the given ports with their protocol defaulted to TCP, as done by the
+// API server, so the spec of the existing network policy does not drift from the desired one.
+func networkPolicyPorts(ports []networkingv1.NetworkPolicyPort) []networkingv1.NetworkPolicyPort {
+	var policyPorts []networkingv1.NetworkPolicyPort
+	for _, port := range ports {
+		port.Protocol = ptr.To(ptr.Deref(port.Protocol, corev1.ProtocolTCP))
+		policyPorts = append(policyPorts, port)
+	}
+	return policyPorts
+}
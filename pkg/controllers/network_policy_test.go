@@ -0,0 +1,280 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2/ktesting"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	"sigs.k8s.io/jobset/pkg/constants"
+	testutils "sigs.k8s.io/jobset/pkg/util/testing"
+)
+
+func TestConstructNetworkPolicy(t *testing.T) {
+	var (
+		jobSetName = "test-jobset"
+		ns         = "default"
+		jobSetUID  = "test-jobset-uid"
+	)
+
+	jobSetSelector := metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			jobset.JobSetNameKey: jobSetName,
+			jobset.JobSetUIDKey:  jobSetUID,
+		},
+	}
+	makeNetworkPolicy := func(ingress ...networkingv1.NetworkPolicyIngressRule) *networkingv1.NetworkPolicy {
+		return &networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      jobSetName + "-isolation",
+				Namespace: ns,
+			},
+			Spec: networkingv1.NetworkPolicySpec{
+				PodSelector: jobSetSelector,
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+				Ingress:     ingress,
+			},
+		}
+	}
+	monitoringPeer := networkingv1.NetworkPolicyPeer{
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"name": "monitoring"}},
+	}
+	dashboardPort := networkingv1.NetworkPolicyPort{
+		Protocol: ptr.To(corev1.ProtocolTCP),
+		Port:     ptr.To(intstr.FromInt32(8265)),
+	}
+
+	tests := []struct {
+		name string
+		js   *jobset.JobSet
+		want *networkingv1.NetworkPolicy
+	}{
+		{
+			name: "isolation not enabled",
+			js:   testutils.MakeJobSet(jobSetName, ns).Obj(),
+		},
+		{
+			name: "only the pods of the jobset are allowed",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				NetworkIsolation(&jobset.NetworkIsolation{}).
+				Obj(),
+			want: makeNetworkPolicy(networkingv1.NetworkPolicyIngressRule{
+				From: []networkingv1.NetworkPolicyPeer{{PodSelector: &jobSetSelector}},
+			}),
+		},
+		{
+			name: "the pods of the network group are allowed",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				NetworkSubdomain("shared").
+				NetworkGroup("training").
+				NetworkIsolation(&jobset.NetworkIsolation{}).
+				Obj(),
+			want: makeNetworkPolicy(networkingv1.NetworkPolicyIngressRule{
+				From: []networkingv1.NetworkPolicyPeer{{
+					PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{jobset.NetworkGroupKey: "training"}},
+				}},
+			}),
+		},
+		{
+			name: "additional peers and ports are allowed",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				NetworkIsolation(&jobset.NetworkIsolation{
+					From:  []networkingv1.NetworkPolicyPeer{monitoringPeer},
+					Ports: []networkingv1.NetworkPolicyPort{dashboardPort},
+				}).
+				Obj(),
+			want: makeNetworkPolicy(
+				networkingv1.NetworkPolicyIngressRule{
+					From: []networkingv1.NetworkPolicyPeer{{PodSelector: &jobSetSelector}},
+				},
+				networkingv1.NetworkPolicyIngressRule{
+					From: []networkingv1.NetworkPolicyPeer{monitoringPeer},
+				},
+				networkingv1.NetworkPolicyIngressRule{
+					Ports: []networkingv1.NetworkPolicyPort{dashboardPort},
+				},
+			),
+		},
+		{
+			name: "additional ports default to TCP",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				NetworkIsolation(&jobset.NetworkIsolation{
+					Ports: []networkingv1.NetworkPolicyPort{{Port: ptr.To(intstr.FromInt32(8265))}},
+				}).
+				Obj(),
+			want: makeNetworkPolicy(
+				networkingv1.NetworkPolicyIngressRule{
+					From: []networkingv1.NetworkPolicyPeer{{PodSelector: &jobSetSelector}},
+				},
+				networkingv1.NetworkPolicyIngressRule{
+					Ports: []networkingv1.NetworkPolicyPort{dashboardPort},
+				},
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.js.UID = types.UID(jobSetUID)
+			if diff := cmp.Diff(tc.want, constructNetworkPolicy(tc.js)); diff != "" {
+				t.Errorf("unexpected network policy (-want/+got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestReconcileNetworkPolicy(t *testing.T) {
+	var (
+		jobSetName = "test-jobset"
+		ns         = "default"
+		jobSetUID  = "test-jobset-uid"
+		policyName = "test-jobset-isolation"
+	)
+
+	jobSetOwnerRef := metav1.OwnerReference{
+		APIVersion:         "jobset.x-k8s.io/v1alpha2",
+		Kind:               "JobSet",
+		Name:               jobSetName,
+		UID:                types.UID(jobSetUID),
+		Controller:         ptr.To(true),
+		BlockOwnerDeletion: ptr.To(true),
+	}
+	staleSpec := networkingv1.NetworkPolicySpec{
+		PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "other"}},
+		PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+	}
+	js := testutils.MakeJobSet(jobSetName, ns).
+		NetworkIsolation(&jobset.NetworkIsolation{}).
+		Obj()
+	js.UID = types.UID(jobSetUID)
+	desiredSpec := constructNetworkPolicy(js).Spec
+
+	reconciledCondition := metav1.Condition{
+		Type:    string(jobset.JobSetNetworkPolicyConflict),
+		Status:  metav1.ConditionFalse,
+		Reason:  constants.NetworkPolicyReconciledReason,
+		Message: constants.NetworkPolicyReconciledMessage,
+	}
+
+	tests := []struct {
+		name           string
+		conditions     []metav1.Condition
+		existing       *networkingv1.NetworkPolicy
+		wantEnforced   bool
+		wantOwnerRefs  []metav1.OwnerReference
+		wantSpec       networkingv1.NetworkPolicySpec
+		wantConditions []metav1.Condition
+	}{
+		{
+			name:          "network policy is created",
+			wantEnforced:  true,
+			wantOwnerRefs: []metav1.OwnerReference{jobSetOwnerRef},
+			wantSpec:      desiredSpec,
+		},
+		{
+			name: "owned network policy with a drifted spec is reconciled",
+			existing: &networkingv1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: policyName, Namespace: ns, OwnerReferences: []metav1.OwnerReference{jobSetOwnerRef}},
+				Spec:       staleSpec,
+			},
+			wantEnforced:  true,
+			wantOwnerRefs: []metav1.OwnerReference{jobSetOwnerRef},
+			wantSpec:      desiredSpec,
+		},
+		{
+			name: "unowned network policy is left untouched and conflicts",
+			existing: &networkingv1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: policyName, Namespace: ns},
+				Spec:       staleSpec,
+			},
+			wantSpec: staleSpec,
+			wantConditions: []metav1.Condition{{
+				Type:    string(jobset.JobSetNetworkPolicyConflict),
+				Status:  metav1.ConditionTrue,
+				Reason:  constants.NetworkPolicyConflictReason,
+				Message: `network policy "test-jobset-isolation" already exists and is not controlled by the jobset`,
+			}},
+		},
+		{
+			name: "resolved conflict is cleared",
+			conditions: []metav1.Condition{{
+				Type:   string(jobset.JobSetNetworkPolicyConflict),
+				Status: metav1.ConditionTrue,
+				Reason: constants.NetworkPolicyConflictReason,
+			}},
+			wantEnforced:   true,
+			wantOwnerRefs:  []metav1.OwnerReference{jobSetOwnerRef},
+			wantSpec:       desiredSpec,
+			wantConditions: []metav1.Condition{reconciledCondition},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			scheme := runtime.NewScheme()
+			utilruntime.Must(jobset.AddToScheme(scheme))
+			utilruntime.Must(networkingv1.AddToScheme(scheme))
+			fakeClientBuilder := fake.NewClientBuilder().WithScheme(scheme)
+			if tc.existing != nil {
+				fakeClientBuilder.WithObjects(tc.existing)
+			}
+			fakeClient := fakeClientBuilder.Build()
+			r := &JobSetReconciler{
+				Client: fakeClient,
+				Scheme: scheme,
+				Record: record.NewFakeRecorder(10),
+			}
+
+			js := js.DeepCopy()
+			js.Status.Conditions = tc.conditions
+			enforced, err := r.reconcileNetworkPolicy(ctx, js, &statusUpdateOpts{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if enforced != tc.wantEnforced {
+				t.Errorf("unexpected enforced value: want %v, got %v", tc.wantEnforced, enforced)
+			}
+
+			var got networkingv1.NetworkPolicy
+			if err := fakeClient.Get(ctx, client.ObjectKey{Name: policyName, Namespace: ns}, &got); err != nil {
+				t.Fatalf("unexpected error getting the network policy: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantOwnerRefs, got.OwnerReferences); diff != "" {
+				t.Errorf("unexpected network policy owner references (-want/+got): %s", diff)
+			}
+			if diff := cmp.Diff(tc.wantSpec, got.Spec); diff != "" {
+				t.Errorf("unexpected network policy spec (-want/+got): %s", diff)
+			}
+			if diff := cmp.Diff(tc.wantConditions, js.Status.Conditions, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("unexpected conditions (-want/+got): %s", diff)
+			}
+		})
+	}
+}
@@ -46,6 +46,10 @@ const (
 	// the namespaced job name of the job that owns this pod, and value is
 	// the pod itself.
 	podJobKey string = "podJobKey"
+
+	// podJobSetKey is the key used for building an index where the key is the
+	// name of the JobSet that owns this pod, and value is the pod itself.
+	podJobSetKey string = "podJobSetKey"
 )
 
 // PodReconciler reconciles a Pod owned by a JobSet using exclusive placement.
@@ -78,6 +82,11 @@ func SetupPodIndexes(ctx context.Context, indexer client.FieldIndexer) error {
 	if err := indexer.IndexField(ctx, &corev1.Pod{}, podJobKey, IndexPodJob); err != nil {
 		return err
 	}
+	// Build index where the key is the name of the JobSet that owns this pod,
+	// and value is the pod itself.
+	if err := indexer.IndexField(ctx, &corev1.Pod{}, podJobSetKey, IndexPodJobSet); err != nil {
+		return err
+	}
 	// Build index where the key is the pod name (without the random suffix), and the value is the pod itself.
 	return indexer.IndexField(ctx, &corev1.Pod{}, PodNameKey, IndexPodName)
 }
@@ -95,6 +104,19 @@ func IndexPodJob(obj client.Object) []string {
 	return []string{jobKey}
 }
 
+func IndexPodJobSet(obj client.Object) []string {
+	pod := obj.(*corev1.Pod)
+	// Make sure the pod has reported its in-place restart attempt.
+	if _, exists := pod.Annotations[jobset.InPlaceRestartAttemptKey]; !exists {
+		return nil
+	}
+	jobSetName, exists := pod.Labels[jobset.JobSetNameKey]
+	if !exists {
+		return nil
+	}
+	return []string{jobSetName}
+}
+
 func IndexPodName(obj client.Object) []string {
 	pod := obj.(*corev1.Pod)
 	// Make sure the pod is part of a JobSet using exclusive placement.
@@ -0,0 +1,160 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	"sigs.k8s.io/jobset/pkg/constants"
+)
+
+// updateObservedGeneration sets the generation of the JobSet observed by the controller in its status.
+func updateObservedGeneration(js *jobset.JobSet, updateStatusOpts *statusUpdateOpts) {
+	if js.Status.ObservedGeneration == js.Generation {
+		return
+	}
+	js.Status.ObservedGeneration = js.Generation
+	updateStatusOpts.shouldUpdate = true
+}
+
+// updateReadyAndProgressingConditions sets the Ready and Progressing conditions of the JobSet
+// from the statuses of its ReplicatedJobs:
+//  1. Ready is true while every started ReplicatedJob has as many ready or succeeded child Jobs as replicas.
+//  2. Progressing is true while the child Jobs are being created or restarted, i.e. while an
+//     active JobSet is not ready. Its reason is RestartingJobs while the Jobs of the current
+//     attempt of a restarted JobSet are being recreated, and CreatingJobs otherwise.
+func updateReadyAndProgressingConditions(js *jobset.JobSet, rjobStatuses []jobset.ReplicatedJobStatus, ownedJobs *childJobs, updateStatusOpts *statusUpdateOpts) {
+	startedRJobs := startedReplicatedJobs(js, rjobStatuses)
+	ready := !jobSetFinished(js) && allReplicatedJobsReady(startedRJobs, rjobStatuses)
+	setCondition(js, makeReadyConditionOpts(ready), updateStatusOpts)
+
+	switch {
+	case jobSetFinished(js):
+		setProgressingCondition(js, metav1.ConditionFalse, constants.JobSetFinishedReason, constants.JobSetFinishedMessage, updateStatusOpts)
+	case jobSetSuspended(js):
+		setProgressingCondition(js, metav1.ConditionFalse, constants.JobSetSuspendedReason, constants.JobSetSuspendedMessage, updateStatusOpts)
+	case ready:
+		setProgressingCondition(js, metav1.ConditionFalse, constants.AllJobsReadyReason, constants.AllJobsReadyMessage, updateStatusOpts)
+	case jobsBeingRecreated(js, startedRJobs, ownedJobs):
+		setProgressingCondition(js, metav1.ConditionTrue, constants.RestartingJobsReason, constants.RestartingJobsMessage, updateStatusOpts)
+	default:
+		setProgressingCondition(js, metav1.ConditionTrue, constants.CreatingJobsReason, constants.CreatingJobsMessage, updateStatusOpts)
+	}
+}
+
+// setProgressingCondition sets the Progressing condition of the JobSet. Unlike the other conditions,
+// its reason and message are updated when its status does not change, e.g. when the Jobs of a
+// restarted JobSet have been recreated and are being started.
+func setProgressingCondition(js *jobset.JobSet, status metav1.ConditionStatus, reason, msg string, updateStatusOpts *statusUpdateOpts) {
+	if cond := meta.FindStatusCondition(js.Status.Conditions, string(jobset.JobSetProgressing)); cond != nil && cond.Status == status {
+		if cond.Reason != reason {
+			cond.Reason = reason
+			cond.Message = msg
+			updateStatusOpts.shouldUpdate = true
+		}
+		return
+	}
+	setCondition(js, makeProgressingConditionOpts(status, reason, msg), updateStatusOpts)
+}
+
+// startedReplicatedJobs returns the ReplicatedJobs whose child Jobs are expected to run, i.e. the
+// ReplicatedJobs which are not suspended and whose dependencies are reached.
+func startedReplicatedJobs(js *jobset.JobSet, rjobStatuses []jobset.ReplicatedJobStatus) []jobset.ReplicatedJob {
+	_, dependenciesReached := evaluateDependencies(js, rjobStatuses)
+	var started []jobset.ReplicatedJob
+	for _, rjob := range js.Spec.ReplicatedJobs {
+		if replicatedJobSuspended(&rjob) || !dependenciesReached.Has(rjob.Name) {
+			continue
+		}
+		started = append(started, rjob)
+	}
+	return started
+}
+
+// allReplicatedJobsReady returns true if the number of ready or succeeded child Jobs of every
+// started ReplicatedJob reaches its replicas, so that the ReplicatedJobs which already succeeded,
+// e.g. an initializer, do not prevent the JobSet from being ready while the others are running.
+// A JobSet without any started ReplicatedJob is not ready.
+func allReplicatedJobsReady(startedRJobs []jobset.ReplicatedJob, rjobStatuses []jobset.ReplicatedJobStatus) bool {
+	readyJobs := map[string]int32{}
+	for _, status := range rjobStatuses {
+		readyJobs[status.Name] = status.Ready + status.Succeeded
+	}
+	for _, rjob := range startedRJobs {
+		if readyJobs[rjob.Name] < rjob.Replicas {
+			return false
+		}
+	}
+	return len(startedRJobs) > 0
+}
+
+// jobsBeingRecreated returns true if the JobSet has been restarted and the Jobs of its current
+// attempt are still being recreated, i.e. the Jobs of a previous attempt are still being deleted,
+// or a started ReplicatedJob has fewer Jobs of the current attempt than replicas.
+func jobsBeingRecreated(js *jobset.JobSet, startedRJobs []jobset.ReplicatedJob, ownedJobs *childJobs) bool {
+	if js.Status.Restarts == 0 {
+		return false
+	}
+	if len(ownedJobs.previous) > 0 {
+		return true
+	}
+	currentJobs := map[string]int32{}
+	for _, jobs := range [][]*batchv1.Job{ownedJobs.active, ownedJobs.successful, ownedJobs.failed} {
+		for _, job := range jobs {
+			currentJobs[job.Labels[jobset.ReplicatedJobNameKey]]++
+		}
+	}
+	for _, rjob := range startedRJobs {
+		if currentJobs[rjob.Name] < rjob.Replicas {
+			return true
+		}
+	}
+	return false
+}
+
+// makeReadyConditionOpts returns the options we use to generate the JobSet ready condition.
+func makeReadyConditionOpts(ready bool) *conditionOpts {
+	condition := &metav1.Condition{
+		Type:    string(jobset.JobSetReady),
+		Status:  metav1.ConditionTrue,
+		Reason:  constants.AllJobsReadyReason,
+		Message: constants.AllJobsReadyMessage,
+	}
+	if !ready {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = constants.JobsNotReadyReason
+		condition.Message = constants.JobsNotReadyMessage
+	}
+	return &conditionOpts{
+		eventType: corev1.EventTypeNormal,
+		condition: condition,
+	}
+}
+
+// makeProgressingConditionOpts returns the options we use to generate the JobSet progressing condition.
+func makeProgressingConditionOpts(status metav1.ConditionStatus, reason, msg string) *conditionOpts {
+	return &conditionOpts{
+		eventType: corev1.EventTypeNormal,
+		condition: &metav1.Condition{
+			Type:    string(jobset.JobSetProgressing),
+			Status:  status,
+			Reason:  reason,
+			Message: msg,
+		},
+	}
+}
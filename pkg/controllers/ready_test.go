@@ -0,0 +1,325 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	"sigs.k8s.io/jobset/pkg/constants"
+	testutils "sigs.k8s.io/jobset/pkg/util/testing"
+)
+
+func TestUpdateReadyAndProgressingConditions(t *testing.T) {
+	var (
+		jobSetName = "test-jobset"
+		ns         = "default"
+	)
+
+	readyCondition := func(status metav1.ConditionStatus, reason string) metav1.Condition {
+		return metav1.Condition{Type: string(jobset.JobSetReady), Status: status, Reason: reason}
+	}
+	progressingCondition := func(status metav1.ConditionStatus, reason string) metav1.Condition {
+		return metav1.Condition{Type: string(jobset.JobSetProgressing), Status: status, Reason: reason}
+	}
+	workerJob := func(name string) *batchv1.Job {
+		return testutils.MakeJob(name, ns).JobLabels(map[string]string{jobset.ReplicatedJobNameKey: "workers"}).Obj()
+	}
+
+	tests := []struct {
+		name             string
+		js               *jobset.JobSet
+		rjobStatuses     []jobset.ReplicatedJobStatus
+		ownedJobs        *childJobs
+		wantConditions   []metav1.Condition
+		wantShouldUpdate bool
+	}{
+		{
+			name: "jobs are being created",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				ReplicatedJob(testutils.MakeReplicatedJob("leader").Replicas(1).Obj()).
+				ReplicatedJob(testutils.MakeReplicatedJob("workers").Replicas(2).Obj()).
+				Obj(),
+			rjobStatuses: []jobset.ReplicatedJobStatus{
+				{Name: "leader", Ready: 1},
+				{Name: "workers", Ready: 1},
+			},
+			wantConditions: []metav1.Condition{
+				progressingCondition(metav1.ConditionTrue, constants.CreatingJobsReason),
+			},
+			wantShouldUpdate: true,
+		},
+		{
+			name: "all jobs are ready",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				ReplicatedJob(testutils.MakeReplicatedJob("leader").Replicas(1).Obj()).
+				ReplicatedJob(testutils.MakeReplicatedJob("workers").Replicas(2).Obj()).
+				SetStatus(jobset.JobSetStatus{
+					Conditions: []metav1.Condition{
+						progressingCondition(metav1.ConditionTrue, constants.CreatingJobsReason),
+					},
+				}).
+				Obj(),
+			rjobStatuses: []jobset.ReplicatedJobStatus{
+				{Name: "leader", Ready: 1},
+				{Name: "workers", Ready: 2},
+			},
+			wantConditions: []metav1.Condition{
+				progressingCondition(metav1.ConditionFalse, constants.AllJobsReadyReason),
+				readyCondition(metav1.ConditionTrue, constants.AllJobsReadyReason),
+			},
+			wantShouldUpdate: true,
+		},
+		{
+			name: "succeeded jobs count as ready",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				ReplicatedJob(testutils.MakeReplicatedJob("initializer").Replicas(1).Obj()).
+				ReplicatedJob(testutils.MakeReplicatedJob("workers").Replicas(2).Obj()).
+				Obj(),
+			rjobStatuses: []jobset.ReplicatedJobStatus{
+				{Name: "initializer", Succeeded: 1},
+				{Name: "workers", Ready: 1, Succeeded: 1},
+			},
+			wantConditions: []metav1.Condition{
+				readyCondition(metav1.ConditionTrue, constants.AllJobsReadyReason),
+			},
+			wantShouldUpdate: true,
+		},
+		{
+			name: "suspended replicated jobs are skipped",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				ReplicatedJob(testutils.MakeReplicatedJob("workers").Replicas(2).Obj()).
+				ReplicatedJob(testutils.MakeReplicatedJob("evaluator").Replicas(1).Suspend(true).Obj()).
+				Obj(),
+			rjobStatuses: []jobset.ReplicatedJobStatus{
+				{Name: "workers", Ready: 2},
+				{Name: "evaluator", Suspend: true},
+			},
+			wantConditions: []metav1.Condition{
+				readyCondition(metav1.ConditionTrue, constants.AllJobsReadyReason),
+			},
+			wantShouldUpdate: true,
+		},
+		{
+			name: "jobset with all its replicated jobs suspended is not ready",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				ReplicatedJob(testutils.MakeReplicatedJob("workers").Replicas(2).Suspend(true).Obj()).
+				Obj(),
+			rjobStatuses: []jobset.ReplicatedJobStatus{
+				{Name: "workers", Suspend: true},
+			},
+			wantConditions: []metav1.Condition{
+				progressingCondition(metav1.ConditionTrue, constants.CreatingJobsReason),
+			},
+			wantShouldUpdate: true,
+		},
+		{
+			name: "replicated jobs whose dependencies are not reached are skipped",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				ReplicatedJob(testutils.MakeReplicatedJob("initializer").Replicas(1).Obj()).
+				ReplicatedJob(testutils.MakeReplicatedJob("workers").Replicas(2).
+					DependsOn([]jobset.DependsOn{{Name: "initializer", Status: jobset.DependencyComplete}}).
+					Obj()).
+				Obj(),
+			rjobStatuses: []jobset.ReplicatedJobStatus{
+				{Name: "initializer", Ready: 1},
+				{Name: "workers"},
+			},
+			wantConditions: []metav1.Condition{
+				readyCondition(metav1.ConditionTrue, constants.AllJobsReadyReason),
+			},
+			wantShouldUpdate: true,
+		},
+		{
+			name: "replicated jobs whose dependencies are reached are waited for",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				ReplicatedJob(testutils.MakeReplicatedJob("initializer").Replicas(1).Obj()).
+				ReplicatedJob(testutils.MakeReplicatedJob("workers").Replicas(2).
+					DependsOn([]jobset.DependsOn{{Name: "initializer", Status: jobset.DependencyComplete}}).
+					Obj()).
+				Obj(),
+			rjobStatuses: []jobset.ReplicatedJobStatus{
+				{Name: "initializer", Succeeded: 1},
+				{Name: "workers", Ready: 1},
+			},
+			wantConditions: []metav1.Condition{
+				progressingCondition(metav1.ConditionTrue, constants.CreatingJobsReason),
+			},
+			wantShouldUpdate: true,
+		},
+		{
+			name: "ready jobset is unchanged",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				ReplicatedJob(testutils.MakeReplicatedJob("workers").Replicas(2).Obj()).
+				SetStatus(jobset.JobSetStatus{
+					Conditions: []metav1.Condition{
+						progressingCondition(metav1.ConditionFalse, constants.AllJobsReadyReason),
+						readyCondition(metav1.ConditionTrue, constants.AllJobsReadyReason),
+					},
+				}).
+				Obj(),
+			rjobStatuses: []jobset.ReplicatedJobStatus{
+				{Name: "workers", Ready: 2},
+			},
+			wantConditions: []metav1.Condition{
+				progressingCondition(metav1.ConditionFalse, constants.AllJobsReadyReason),
+				readyCondition(metav1.ConditionTrue, constants.AllJobsReadyReason),
+			},
+		},
+		{
+			name: "jobs are being recreated after a restart",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				ReplicatedJob(testutils.MakeReplicatedJob("workers").Replicas(2).Obj()).
+				SetStatus(jobset.JobSetStatus{
+					Restarts: 1,
+					Conditions: []metav1.Condition{
+						progressingCondition(metav1.ConditionFalse, constants.AllJobsReadyReason),
+						readyCondition(metav1.ConditionTrue, constants.AllJobsReadyReason),
+					},
+				}).
+				Obj(),
+			rjobStatuses: []jobset.ReplicatedJobStatus{
+				{Name: "workers", Ready: 0},
+			},
+			ownedJobs: &childJobs{
+				active: []*batchv1.Job{workerJob("workers-0")},
+			},
+			wantConditions: []metav1.Condition{
+				progressingCondition(metav1.ConditionTrue, constants.RestartingJobsReason),
+				readyCondition(metav1.ConditionFalse, constants.JobsNotReadyReason),
+			},
+			wantShouldUpdate: true,
+		},
+		{
+			name: "jobs of the previous attempt are being deleted after a restart",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				ReplicatedJob(testutils.MakeReplicatedJob("workers").Replicas(2).Obj()).
+				SetStatus(jobset.JobSetStatus{Restarts: 1}).
+				Obj(),
+			rjobStatuses: []jobset.ReplicatedJobStatus{
+				{Name: "workers", Ready: 0},
+			},
+			ownedJobs: &childJobs{
+				active:   []*batchv1.Job{workerJob("workers-0"), workerJob("workers-1")},
+				previous: []*batchv1.Job{workerJob("workers-1")},
+			},
+			wantConditions: []metav1.Condition{
+				progressingCondition(metav1.ConditionTrue, constants.RestartingJobsReason),
+			},
+			wantShouldUpdate: true,
+		},
+		{
+			name: "jobs of a restarted jobset are being started once recreated",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				ReplicatedJob(testutils.MakeReplicatedJob("workers").Replicas(2).Obj()).
+				SetStatus(jobset.JobSetStatus{
+					Restarts: 1,
+					Conditions: []metav1.Condition{
+						progressingCondition(metav1.ConditionTrue, constants.RestartingJobsReason),
+					},
+				}).
+				Obj(),
+			rjobStatuses: []jobset.ReplicatedJobStatus{
+				{Name: "workers", Ready: 1},
+			},
+			ownedJobs: &childJobs{
+				active: []*batchv1.Job{workerJob("workers-0"), workerJob("workers-1")},
+			},
+			wantConditions: []metav1.Condition{
+				progressingCondition(metav1.ConditionTrue, constants.CreatingJobsReason),
+			},
+			wantShouldUpdate: true,
+		},
+		{
+			name: "suspended jobset is not progressing",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				Suspend(true).
+				ReplicatedJob(testutils.MakeReplicatedJob("workers").Replicas(2).Obj()).
+				SetStatus(jobset.JobSetStatus{
+					Conditions: []metav1.Condition{
+						progressingCondition(metav1.ConditionTrue, constants.CreatingJobsReason),
+					},
+				}).
+				Obj(),
+			wantConditions: []metav1.Condition{
+				progressingCondition(metav1.ConditionFalse, constants.JobSetSuspendedReason),
+			},
+			wantShouldUpdate: true,
+		},
+		{
+			name: "finished jobset is neither ready nor progressing",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				ReplicatedJob(testutils.MakeReplicatedJob("workers").Replicas(2).Obj()).
+				SetStatus(jobset.JobSetStatus{
+					Conditions: []metav1.Condition{
+						progressingCondition(metav1.ConditionFalse, constants.AllJobsReadyReason),
+						readyCondition(metav1.ConditionTrue, constants.AllJobsReadyReason),
+					},
+				}).
+				CompletedCondition(metav1.Now()).
+				Obj(),
+			rjobStatuses: []jobset.ReplicatedJobStatus{
+				{Name: "workers", Ready: 2},
+			},
+			wantConditions: []metav1.Condition{
+				{Type: string(jobset.JobSetCompleted), Status: metav1.ConditionTrue},
+				progressingCondition(metav1.ConditionFalse, constants.JobSetFinishedReason),
+				readyCondition(metav1.ConditionFalse, constants.JobsNotReadyReason),
+			},
+			wantShouldUpdate: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := &statusUpdateOpts{}
+			ownedJobs := tc.ownedJobs
+			if ownedJobs == nil {
+				ownedJobs = &childJobs{}
+			}
+			updateReadyAndProgressingConditions(tc.js, tc.rjobStatuses, ownedJobs, opts)
+			if diff := cmp.Diff(tc.wantConditions, tc.js.Status.Conditions,
+				cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime", "Message"),
+				cmpopts.SortSlices(func(a, b metav1.Condition) bool { return a.Type < b.Type })); diff != "" {
+				t.Errorf("unexpected conditions (-want/+got): %s", diff)
+			}
+			if opts.shouldUpdate != tc.wantShouldUpdate {
+				t.Errorf("unexpected shouldUpdate: want %v, got %v", tc.wantShouldUpdate, opts.shouldUpdate)
+			}
+		})
+	}
+}
+
+func TestUpdateObservedGeneration(t *testing.T) {
+	js := testutils.MakeJobSet("test-jobset", "default").Obj()
+	js.Generation = 2
+	js.Status.ObservedGeneration = 1
+
+	opts := &statusUpdateOpts{}
+	updateObservedGeneration(js, opts)
+	if js.Status.ObservedGeneration != 2 || !opts.shouldUpdate {
+		t.Errorf("unexpected observed generation %d, shouldUpdate %v", js.Status.ObservedGeneration, opts.shouldUpdate)
+	}
+
+	opts = &statusUpdateOpts{}
+	updateObservedGeneration(js, opts)
+	if opts.shouldUpdate {
+		t.Error("unexpected status update when the observed generation is up to date")
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+)
+
+// executeRestartBackoff delays a JobSet restart according to the restartBackoff of the failure policy.
+// If the restart should be delayed, it returns the time after which the JobSet should be requeued.
+// If the JobSet can be restarted now, or no restart backoff is configured, it returns 0.
+func executeRestartBackoff(ctx context.Context, clock clock.Clock, js *jobset.JobSet, rule *jobset.FailurePolicyRule, failedJob *batchv1.Job, action jobset.FailurePolicyAction, updateStatusOpts *statusUpdateOpts) time.Duration {
+	log := ctrl.LoggerFrom(ctx)
+
+	if js.Spec.FailurePolicy == nil || js.Spec.FailurePolicy.RestartBackoff == nil {
+		return 0
+	}
+	// Only restarts are delayed. A JobSet which exhausted its restarts is failed immediately.
+	if action != jobset.RestartJobSet && action != jobset.RestartJobSetAndIgnoreMaxRestarts {
+		return 0
+	}
+	if action == jobset.RestartJobSet && reachedMaxRestarts(js, rule, failedJob) {
+		return 0
+	}
+
+	now := clock.Now()
+	if js.Status.NextRestartTime == nil {
+		restarts := consecutiveRestarts(js)
+		delay := restartBackoffDelay(js.Spec.FailurePolicy.RestartBackoff, restarts)
+		js.Status.NextRestartTime = &metav1.Time{Time: now.Add(delay)}
+		updateStatusOpts.shouldUpdate = true
+		log.V(2).Info("delaying restart", "restart attempt", js.Status.Restarts+1, "consecutive restarts", restarts, "delay", delay)
+		return delay
+	}
+
+	if remaining := js.Status.NextRestartTime.Sub(now); remaining > 0 {
+		return remaining
+	}
+
+	// The backoff has expired, so the JobSet can be restarted.
+	js.Status.NextRestartTime = nil
+	updateStatusOpts.shouldUpdate = true
+	return 0
+}
+
+// consecutiveRestarts returns the number of restarts of the JobSet since it was last healthy, i.e. since
+// its Ready condition last became false, or since it was created if it has never been ready. It is 0
+// while the JobSet is ready. The restarts are counted from the restart history, so at most
+// maxRestartHistory restarts are counted.
+func consecutiveRestarts(js *jobset.JobSet) int32 {
+	ready := meta.FindStatusCondition(js.Status.Conditions, string(jobset.JobSetReady))
+	if ready != nil && ready.Status == metav1.ConditionTrue {
+		return 0
+	}
+	var restarts int32
+	for _, entry := range js.Status.RestartHistory {
+		if ready == nil || !entry.Time.Before(&ready.LastTransitionTime) {
+			restarts++
+		}
+	}
+	return restarts
+}
+
+// defaultRestartBackoffMaxDelay is the maximum delay between restarts if maxDelaySeconds is unset.
+const defaultRestartBackoffMaxDelay = 10 * time.Minute
+
+// restartBackoffDelay returns the delay before the next restart of a JobSet which has already
+// been restarted the given number of times in a row.
+func restartBackoffDelay(backoff *jobset.RestartBackoff, restarts int32) time.Duration {
+	maxDelay := defaultRestartBackoffMaxDelay
+	if backoff.MaxDelaySeconds > 0 {
+		maxDelay = time.Duration(backoff.MaxDelaySeconds) * time.Second
+	}
+	multiplier := time.Duration(max(backoff.Multiplier, 1))
+
+	delay := time.Duration(backoff.InitialDelaySeconds) * time.Second
+	for i := int32(0); i < restarts && delay < maxDelay; i++ {
+		delay *= multiplier
+	}
+	return min(delay, maxDelay)
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2/ktesting"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	testutils "sigs.k8s.io/jobset/pkg/util/testing"
+)
+
+func TestExecuteRestartBackoff(t *testing.T) {
+	var (
+		jobSetName = "test-jobset"
+		ns         = "default"
+	)
+
+	now := metav1.NewTime(time.Now().Truncate(time.Second))
+	backoff := &jobset.RestartBackoff{
+		InitialDelaySeconds: 10,
+		Multiplier:          2,
+		MaxDelaySeconds:     60,
+	}
+	restartHistory := func(times ...metav1.Time) []jobset.RestartHistoryEntry {
+		var history []jobset.RestartHistoryEntry
+		for i, t := range times {
+			history = append(history, jobset.RestartHistoryEntry{Attempt: int32(i + 1), Time: t, Action: jobset.RestartJobSet})
+		}
+		return history
+	}
+	readyCondition := func(status metav1.ConditionStatus, lastTransitionTime metav1.Time) []metav1.Condition {
+		return []metav1.Condition{{Type: string(jobset.JobSetReady), Status: status, LastTransitionTime: lastTransitionTime}}
+	}
+	ago := func(d time.Duration) metav1.Time {
+		return metav1.NewTime(now.Add(-d))
+	}
+
+	tests := []struct {
+		name                string
+		failurePolicy       *jobset.FailurePolicy
+		status              jobset.JobSetStatus
+		action              jobset.FailurePolicyAction
+		wantRequeueAfter    time.Duration
+		wantNextRestartTime *metav1.Time
+		wantShouldUpdate    bool
+	}{
+		{
+			name:          "restart backoff not set",
+			failurePolicy: &jobset.FailurePolicy{MaxRestarts: 3},
+			action:        jobset.RestartJobSet,
+		},
+		{
+			name:          "fail jobset action is not delayed",
+			failurePolicy: &jobset.FailurePolicy{MaxRestarts: 3, RestartBackoff: backoff},
+			action:        jobset.FailJobSet,
+		},
+		{
+			name:          "restart jobset action is not delayed when max restarts is reached",
+			failurePolicy: &jobset.FailurePolicy{MaxRestarts: 3, RestartBackoff: backoff},
+			status:        jobset.JobSetStatus{Restarts: 3, RestartsCountTowardsMax: 3},
+			action:        jobset.RestartJobSet,
+		},
+		{
+			name:                "first restart is delayed by the initial delay",
+			failurePolicy:       &jobset.FailurePolicy{MaxRestarts: 3, RestartBackoff: backoff},
+			action:              jobset.RestartJobSet,
+			wantRequeueAfter:    10 * time.Second,
+			wantNextRestartTime: &metav1.Time{Time: now.Add(10 * time.Second)},
+			wantShouldUpdate:    true,
+		},
+		{
+			name:          "second restart is delayed by the multiplied delay",
+			failurePolicy: &jobset.FailurePolicy{MaxRestarts: 3, RestartBackoff: backoff},
+			status: jobset.JobSetStatus{
+				Restarts:                1,
+				RestartsCountTowardsMax: 1,
+				RestartHistory:          restartHistory(ago(time.Minute)),
+			},
+			action:              jobset.RestartJobSet,
+			wantRequeueAfter:    20 * time.Second,
+			wantNextRestartTime: &metav1.Time{Time: now.Add(20 * time.Second)},
+			wantShouldUpdate:    true,
+		},
+		{
+			name:          "restart ignoring max restarts is delayed by the capped delay",
+			failurePolicy: &jobset.FailurePolicy{MaxRestarts: 3, RestartBackoff: backoff},
+			status: jobset.JobSetStatus{
+				Restarts:                10,
+				RestartsCountTowardsMax: 3,
+				RestartHistory:          restartHistory(ago(4*time.Minute), ago(3*time.Minute), ago(2*time.Minute), ago(time.Minute)),
+			},
+			action:              jobset.RestartJobSetAndIgnoreMaxRestarts,
+			wantRequeueAfter:    60 * time.Second,
+			wantNextRestartTime: &metav1.Time{Time: now.Add(60 * time.Second)},
+			wantShouldUpdate:    true,
+		},
+		{
+			name:          "restarts before the jobset was last ready are not counted",
+			failurePolicy: &jobset.FailurePolicy{MaxRestarts: 5, RestartBackoff: backoff},
+			status: jobset.JobSetStatus{
+				Restarts:                3,
+				RestartsCountTowardsMax: 3,
+				Conditions:              readyCondition(metav1.ConditionFalse, ago(time.Hour)),
+				RestartHistory:          restartHistory(ago(72*time.Hour), ago(48*time.Hour), ago(30*time.Minute)),
+			},
+			action:              jobset.RestartJobSet,
+			wantRequeueAfter:    20 * time.Second,
+			wantNextRestartTime: &metav1.Time{Time: now.Add(20 * time.Second)},
+			wantShouldUpdate:    true,
+		},
+		{
+			name:          "restart of a ready jobset is delayed by the initial delay",
+			failurePolicy: &jobset.FailurePolicy{MaxRestarts: 5, RestartBackoff: backoff},
+			status: jobset.JobSetStatus{
+				Restarts:                3,
+				RestartsCountTowardsMax: 3,
+				Conditions:              readyCondition(metav1.ConditionTrue, ago(24*time.Hour)),
+				RestartHistory:          restartHistory(ago(72*time.Hour), ago(48*time.Hour), ago(25*time.Hour)),
+			},
+			action:              jobset.RestartJobSet,
+			wantRequeueAfter:    10 * time.Second,
+			wantNextRestartTime: &metav1.Time{Time: now.Add(10 * time.Second)},
+			wantShouldUpdate:    true,
+		},
+		{
+			name:                "restart is delayed until the next restart time",
+			failurePolicy:       &jobset.FailurePolicy{MaxRestarts: 3, RestartBackoff: backoff},
+			status:              jobset.JobSetStatus{NextRestartTime: &metav1.Time{Time: now.Add(5 * time.Second)}},
+			action:              jobset.RestartJobSet,
+			wantRequeueAfter:    5 * time.Second,
+			wantNextRestartTime: &metav1.Time{Time: now.Add(5 * time.Second)},
+		},
+		{
+			name:             "restart is allowed once the next restart time has passed",
+			failurePolicy:    &jobset.FailurePolicy{MaxRestarts: 3, RestartBackoff: backoff},
+			status:           jobset.JobSetStatus{NextRestartTime: &metav1.Time{Time: now.Add(-5 * time.Second)}},
+			action:           jobset.RestartJobSet,
+			wantShouldUpdate: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			js := testutils.MakeJobSet(jobSetName, ns).
+				FailurePolicy(tc.failurePolicy).
+				SetStatus(tc.status).
+				Obj()
+			opts := &statusUpdateOpts{}
+			fakeClock := clocktesting.NewFakeClock(now.Time)
+
+			gotRequeueAfter := executeRestartBackoff(ctx, fakeClock, js, nil, nil, tc.action, opts)
+			if gotRequeueAfter != tc.wantRequeueAfter {
+				t.Errorf("unexpected requeue after: want %v, got %v", tc.wantRequeueAfter, gotRequeueAfter)
+			}
+			if diff := cmp.Diff(tc.wantNextRestartTime, js.Status.NextRestartTime); diff != "" {
+				t.Errorf("unexpected next restart time (-want/+got): %s", diff)
+			}
+			if opts.shouldUpdate != tc.wantShouldUpdate {
+				t.Errorf("unexpected shouldUpdate: want %v, got %v", tc.wantShouldUpdate, opts.shouldUpdate)
+			}
+		})
+	}
+}
+
+func TestRestartBackoffDelay(t *testing.T) {
+	tests := []struct {
+		name     string
+		backoff  *jobset.RestartBackoff
+		restarts int32
+		want     time.Duration
+	}{
+		{
+			name:     "first restart",
+			backoff:  &jobset.RestartBackoff{InitialDelaySeconds: 5, Multiplier: 3, MaxDelaySeconds: 100},
+			restarts: 0,
+			want:     5 * time.Second,
+		},
+		{
+			name:     "third restart",
+			backoff:  &jobset.RestartBackoff{InitialDelaySeconds: 5, Multiplier: 3, MaxDelaySeconds: 100},
+			restarts: 2,
+			want:     45 * time.Second,
+		},
+		{
+			name:     "delay is capped",
+			backoff:  &jobset.RestartBackoff{InitialDelaySeconds: 5, Multiplier: 3, MaxDelaySeconds: 100},
+			restarts: 3,
+			want:     100 * time.Second,
+		},
+		{
+			name:     "delay does not overflow for many restarts",
+			backoff:  &jobset.RestartBackoff{InitialDelaySeconds: 5, Multiplier: 10, MaxDelaySeconds: 100},
+			restarts: 1000,
+			want:     100 * time.Second,
+		},
+		{
+			name:     "max delay unset uses the default max delay",
+			backoff:  &jobset.RestartBackoff{InitialDelaySeconds: 5, Multiplier: 2},
+			restarts: 100,
+			want:     defaultRestartBackoffMaxDelay,
+		},
+		{
+			name:     "multiplier unset keeps the initial delay",
+			backoff:  &jobset.RestartBackoff{InitialDelaySeconds: 5, MaxDelaySeconds: 100},
+			restarts: 4,
+			want:     5 * time.Second,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := restartBackoffDelay(tc.backoff, tc.restarts); got != tc.want {
+				t.Errorf("unexpected delay: want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+)
+
+// restartTimesEnabled returns true if the failure policy of the JobSet forgives old restarts,
+// in which case the times of the restarts counting towards maxRestarts are kept in the status.
+func restartTimesEnabled(js *jobset.JobSet) bool {
+	return js.Spec.FailurePolicy != nil &&
+		(js.Spec.FailurePolicy.RestartsWindowSeconds != nil || js.Spec.FailurePolicy.ResetRestartsAfterHealthySeconds != nil)
+}
+
+// executeRestartWindow forgives the restarts of the JobSet and of its ReplicatedJobs which no longer
+// count towards their restart limits:
+//  1. If the JobSet has been Ready for resetRestartsAfterHealthySeconds, all the restarts are forgiven.
+//  2. Otherwise, the restarts older than restartsWindowSeconds are forgiven.
+//
+// The restarts counting towards the limits are then set to the number of remaining restart times.
+// If the JobSet is Ready but its restarts are not due to be reset yet, it returns the time after
+// which the JobSet should be requeued to reset them. Otherwise, it returns 0.
+func executeRestartWindow(ctx context.Context, clock clock.Clock, js *jobset.JobSet, updateStatusOpts *statusUpdateOpts) time.Duration {
+	log := ctrl.LoggerFrom(ctx)
+
+	if !restartTimesEnabled(js) {
+		return 0
+	}
+
+	now := clock.Now()
+	reset, requeueAfter := shouldResetRestarts(js, now)
+
+	restartTimes := forgiveRestarts(js, js.Status.RestartTimes, reset, now)
+	if restartsCountTowardsMax := int32(len(restartTimes)); restartsCountTowardsMax != js.Status.RestartsCountTowardsMax || len(restartTimes) != len(js.Status.RestartTimes) {
+		log.V(2).Info("forgiving restarts", "forgiven restarts", js.Status.RestartsCountTowardsMax-restartsCountTowardsMax)
+		js.Status.RestartTimes = restartTimes
+		js.Status.RestartsCountTowardsMax = restartsCountTowardsMax
+		updateStatusOpts.shouldUpdate = true
+	}
+
+	for i := range js.Status.ReplicatedJobsStatus {
+		status := &js.Status.ReplicatedJobsStatus[i]
+		restartTimes := forgiveRestarts(js, status.RestartTimes, reset, now)
+		if restartsCountTowardsMax := int32(len(restartTimes)); restartsCountTowardsMax != status.RestartsCountTowardsMax || len(restartTimes) != len(status.RestartTimes) {
+			log.V(2).Info("forgiving restarts", "replicatedJob", status.Name, "forgiven restarts", status.RestartsCountTowardsMax-restartsCountTowardsMax)
+			status.RestartTimes = restartTimes
+			status.RestartsCountTowardsMax = restartsCountTowardsMax
+			updateStatusOpts.shouldUpdate = true
+		}
+	}
+
+	return requeueAfter
+}
+
+// shouldResetRestarts returns true if the JobSet has been Ready for resetRestartsAfterHealthySeconds.
+// If the JobSet is Ready but has not been Ready for long enough, and has restarts to forgive, it also
+// returns the time remaining until its restarts are due to be reset.
+func shouldResetRestarts(js *jobset.JobSet, now time.Time) (bool, time.Duration) {
+	resetAfter := js.Spec.FailurePolicy.ResetRestartsAfterHealthySeconds
+	if resetAfter == nil {
+		return false, 0
+	}
+	ready := meta.FindStatusCondition(js.Status.Conditions, string(jobset.JobSetReady))
+	if ready == nil || ready.Status != metav1.ConditionTrue {
+		return false, 0
+	}
+	remaining := time.Duration(*resetAfter)*time.Second - now.Sub(ready.LastTransitionTime.Time)
+	if remaining <= 0 {
+		return true, 0
+	}
+	if !hasRestartTimes(js) {
+		return false, 0
+	}
+	return false, remaining
+}
+
+// hasRestartTimes returns true if any restart of the JobSet or of its ReplicatedJobs counts towards
+// a restart limit.
+func hasRestartTimes(js *jobset.JobSet) bool {
+	if len(js.Status.RestartTimes) > 0 {
+		return true
+	}
+	for _, status := range js.Status.ReplicatedJobsStatus {
+		if len(status.RestartTimes) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// forgiveRestarts returns the restart times which still count towards a restart limit: none if the
+// restarts are reset, otherwise the ones within restartsWindowSeconds.
+func forgiveRestarts(js *jobset.JobSet, restartTimes []metav1.Time, reset bool, now time.Time) []metav1.Time {
+	if reset {
+		return nil
+	}
+	window := js.Spec.FailurePolicy.RestartsWindowSeconds
+	if window == nil {
+		return restartTimes
+	}
+	windowStart := now.Add(-time.Duration(*window) * time.Second)
+	var inWindow []metav1.Time
+	for _, restartTime := range restartTimes {
+		if restartTime.After(windowStart) {
+			inWindow = append(inWindow, restartTime)
+		}
+	}
+	return inWindow
+}
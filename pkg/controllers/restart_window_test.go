@@ -0,0 +1,286 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2/ktesting"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	testutils "sigs.k8s.io/jobset/pkg/util/testing"
+)
+
+func TestExecuteRestartWindow(t *testing.T) {
+	var (
+		jobSetName = "test-jobset"
+		ns         = "default"
+	)
+
+	now := time.Now().Truncate(time.Second)
+	ago := func(d time.Duration) metav1.Time {
+		return metav1.NewTime(now.Add(-d))
+	}
+	readySince := func(d time.Duration) []metav1.Condition {
+		return []metav1.Condition{{
+			Type:               string(jobset.JobSetReady),
+			Status:             metav1.ConditionTrue,
+			Reason:             "AllJobsReady",
+			LastTransitionTime: ago(d),
+		}}
+	}
+	notReadySince := func(d time.Duration) []metav1.Condition {
+		return []metav1.Condition{{
+			Type:               string(jobset.JobSetReady),
+			Status:             metav1.ConditionFalse,
+			Reason:             "JobsNotReady",
+			LastTransitionTime: ago(d),
+		}}
+	}
+
+	tests := []struct {
+		name             string
+		failurePolicy    *jobset.FailurePolicy
+		status           jobset.JobSetStatus
+		wantStatus       jobset.JobSetStatus
+		wantShouldUpdate bool
+		wantRequeueAfter time.Duration
+	}{
+		{
+			name:          "restart window and reset not set",
+			failurePolicy: &jobset.FailurePolicy{MaxRestarts: 3},
+			status:        jobset.JobSetStatus{Restarts: 2, RestartsCountTowardsMax: 2},
+			wantStatus:    jobset.JobSetStatus{Restarts: 2, RestartsCountTowardsMax: 2},
+		},
+		{
+			name:          "all restarts within the window",
+			failurePolicy: &jobset.FailurePolicy{MaxRestarts: 3, RestartsWindowSeconds: ptr.To[int32](3600)},
+			status: jobset.JobSetStatus{
+				Restarts:                2,
+				RestartsCountTowardsMax: 2,
+				RestartTimes:            []metav1.Time{ago(30 * time.Minute), ago(10 * time.Minute)},
+			},
+			wantStatus: jobset.JobSetStatus{
+				Restarts:                2,
+				RestartsCountTowardsMax: 2,
+				RestartTimes:            []metav1.Time{ago(30 * time.Minute), ago(10 * time.Minute)},
+			},
+		},
+		{
+			name:          "restarts outside of the window are forgiven",
+			failurePolicy: &jobset.FailurePolicy{MaxRestarts: 3, RestartsWindowSeconds: ptr.To[int32](3600)},
+			status: jobset.JobSetStatus{
+				Restarts:                3,
+				RestartsCountTowardsMax: 3,
+				RestartTimes:            []metav1.Time{ago(3 * time.Hour), ago(2 * time.Hour), ago(10 * time.Minute)},
+			},
+			wantStatus: jobset.JobSetStatus{
+				Restarts:                3,
+				RestartsCountTowardsMax: 1,
+				RestartTimes:            []metav1.Time{ago(10 * time.Minute)},
+			},
+			wantShouldUpdate: true,
+		},
+		{
+			name:          "restarts are reset after the jobset has been ready for long enough",
+			failurePolicy: &jobset.FailurePolicy{MaxRestarts: 3, ResetRestartsAfterHealthySeconds: ptr.To[int32](3600)},
+			status: jobset.JobSetStatus{
+				Conditions:              readySince(2 * time.Hour),
+				Restarts:                2,
+				RestartsCountTowardsMax: 2,
+				RestartTimes:            []metav1.Time{ago(5 * time.Hour), ago(3 * time.Hour)},
+			},
+			wantStatus: jobset.JobSetStatus{
+				Conditions: readySince(2 * time.Hour),
+				Restarts:   2,
+			},
+			wantShouldUpdate: true,
+		},
+		{
+			name:          "restarts are not reset if the jobset has not been ready for long enough",
+			failurePolicy: &jobset.FailurePolicy{MaxRestarts: 3, ResetRestartsAfterHealthySeconds: ptr.To[int32](3600)},
+			status: jobset.JobSetStatus{
+				Conditions:              readySince(10 * time.Minute),
+				Restarts:                2,
+				RestartsCountTowardsMax: 2,
+				RestartTimes:            []metav1.Time{ago(5 * time.Hour), ago(3 * time.Hour)},
+			},
+			wantStatus: jobset.JobSetStatus{
+				Conditions:              readySince(10 * time.Minute),
+				Restarts:                2,
+				RestartsCountTowardsMax: 2,
+				RestartTimes:            []metav1.Time{ago(5 * time.Hour), ago(3 * time.Hour)},
+			},
+			wantRequeueAfter: 50 * time.Minute,
+		},
+		{
+			name:          "restarts are not reset if the jobset is not ready, however long ago it last restarted",
+			failurePolicy: &jobset.FailurePolicy{MaxRestarts: 3, ResetRestartsAfterHealthySeconds: ptr.To[int32](3600)},
+			status: jobset.JobSetStatus{
+				Conditions:              notReadySince(5 * time.Hour),
+				Restarts:                2,
+				RestartsCountTowardsMax: 2,
+				RestartTimes:            []metav1.Time{ago(6 * time.Hour), ago(5 * time.Hour)},
+			},
+			wantStatus: jobset.JobSetStatus{
+				Conditions:              notReadySince(5 * time.Hour),
+				Restarts:                2,
+				RestartsCountTowardsMax: 2,
+				RestartTimes:            []metav1.Time{ago(6 * time.Hour), ago(5 * time.Hour)},
+			},
+		},
+		{
+			name:          "restarts are not reset if the jobset has never been ready",
+			failurePolicy: &jobset.FailurePolicy{MaxRestarts: 3, ResetRestartsAfterHealthySeconds: ptr.To[int32](3600)},
+			status: jobset.JobSetStatus{
+				Restarts:                2,
+				RestartsCountTowardsMax: 2,
+				RestartTimes:            []metav1.Time{ago(6 * time.Hour), ago(5 * time.Hour)},
+			},
+			wantStatus: jobset.JobSetStatus{
+				Restarts:                2,
+				RestartsCountTowardsMax: 2,
+				RestartTimes:            []metav1.Time{ago(6 * time.Hour), ago(5 * time.Hour)},
+			},
+		},
+		{
+			name:          "no requeue if the jobset is ready and has no restarts to reset",
+			failurePolicy: &jobset.FailurePolicy{MaxRestarts: 3, ResetRestartsAfterHealthySeconds: ptr.To[int32](3600)},
+			status: jobset.JobSetStatus{
+				Conditions: readySince(10 * time.Minute),
+				Restarts:   2,
+			},
+			wantStatus: jobset.JobSetStatus{
+				Conditions: readySince(10 * time.Minute),
+				Restarts:   2,
+			},
+		},
+		{
+			name:          "restarts of replicated jobs are reset after the jobset has been ready for long enough",
+			failurePolicy: &jobset.FailurePolicy{MaxRestarts: 3, ResetRestartsAfterHealthySeconds: ptr.To[int32](3600)},
+			status: jobset.JobSetStatus{
+				Conditions: readySince(2 * time.Hour),
+				Restarts:   2,
+				ReplicatedJobsStatus: []jobset.ReplicatedJobStatus{
+					{
+						Name:                    "rjob-a",
+						Restarts:                2,
+						RestartsCountTowardsMax: 2,
+						RestartTimes:            []metav1.Time{ago(5 * time.Hour), ago(3 * time.Hour)},
+					},
+				},
+			},
+			wantStatus: jobset.JobSetStatus{
+				Conditions: readySince(2 * time.Hour),
+				Restarts:   2,
+				ReplicatedJobsStatus: []jobset.ReplicatedJobStatus{
+					{
+						Name:     "rjob-a",
+						Restarts: 2,
+					},
+				},
+			},
+			wantShouldUpdate: true,
+		},
+		{
+			name:          "restarts of replicated jobs outside of the window are forgiven",
+			failurePolicy: &jobset.FailurePolicy{MaxRestarts: 3, RestartsWindowSeconds: ptr.To[int32](3600)},
+			status: jobset.JobSetStatus{
+				Restarts: 3,
+				ReplicatedJobsStatus: []jobset.ReplicatedJobStatus{
+					{
+						Name:                    "rjob-a",
+						Restarts:                3,
+						RestartsCountTowardsMax: 3,
+						RestartTimes:            []metav1.Time{ago(3 * time.Hour), ago(2 * time.Hour), ago(10 * time.Minute)},
+					},
+					{
+						Name:                    "rjob-b",
+						Restarts:                1,
+						RestartsCountTowardsMax: 1,
+						RestartTimes:            []metav1.Time{ago(20 * time.Minute)},
+					},
+				},
+			},
+			wantStatus: jobset.JobSetStatus{
+				Restarts: 3,
+				ReplicatedJobsStatus: []jobset.ReplicatedJobStatus{
+					{
+						Name:                    "rjob-a",
+						Restarts:                3,
+						RestartsCountTowardsMax: 1,
+						RestartTimes:            []metav1.Time{ago(10 * time.Minute)},
+					},
+					{
+						Name:                    "rjob-b",
+						Restarts:                1,
+						RestartsCountTowardsMax: 1,
+						RestartTimes:            []metav1.Time{ago(20 * time.Minute)},
+					},
+				},
+			},
+			wantShouldUpdate: true,
+		},
+		{
+			name: "restart window applies when the jobset has not been ready for long enough",
+			failurePolicy: &jobset.FailurePolicy{
+				MaxRestarts:                      3,
+				RestartsWindowSeconds:            ptr.To[int32](3600),
+				ResetRestartsAfterHealthySeconds: ptr.To[int32](7200),
+			},
+			status: jobset.JobSetStatus{
+				Conditions:              readySince(30 * time.Minute),
+				Restarts:                2,
+				RestartsCountTowardsMax: 2,
+				RestartTimes:            []metav1.Time{ago(5 * time.Hour), ago(40 * time.Minute)},
+			},
+			wantStatus: jobset.JobSetStatus{
+				Conditions:              readySince(30 * time.Minute),
+				Restarts:                2,
+				RestartsCountTowardsMax: 1,
+				RestartTimes:            []metav1.Time{ago(40 * time.Minute)},
+			},
+			wantShouldUpdate: true,
+			wantRequeueAfter: 90 * time.Minute,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			js := testutils.MakeJobSet(jobSetName, ns).
+				FailurePolicy(tc.failurePolicy).
+				SetStatus(tc.status).
+				Obj()
+			opts := &statusUpdateOpts{}
+			fakeClock := clocktesting.NewFakeClock(now)
+
+			requeueAfter := executeRestartWindow(ctx, fakeClock, js, opts)
+			if diff := cmp.Diff(tc.wantStatus, js.Status); diff != "" {
+				t.Errorf("unexpected status (-want/+got): %s", diff)
+			}
+			if opts.shouldUpdate != tc.wantShouldUpdate {
+				t.Errorf("unexpected shouldUpdate: want %v, got %v", tc.wantShouldUpdate, opts.shouldUpdate)
+			}
+			if requeueAfter != tc.wantRequeueAfter {
+				t.Errorf("unexpected requeueAfter: want %v, got %v", tc.wantRequeueAfter, requeueAfter)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"strconv"
+
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+)
+
+// syncScaleReplicas applies the replicas set through the scale subresource of the JobSet to
+// the replicas of the target ReplicatedJob. The resource version and generation of the JobSet are
+// updated so the status update performed later in the same reconciliation attempt does not conflict.
+func (r *JobSetReconciler) syncScaleReplicas(ctx context.Context, js *jobset.JobSet) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	if js.Spec.Scale == nil || js.Spec.Scale.Replicas == nil {
+		return nil
+	}
+	for i, rJob := range js.Spec.ReplicatedJobs {
+		if rJob.Name != js.Spec.Scale.ReplicatedJob || rJob.Replicas == *js.Spec.Scale.Replicas {
+			continue
+		}
+		scaled := js.DeepCopy()
+		scaled.Spec.ReplicatedJobs[i].Replicas = *js.Spec.Scale.Replicas
+		if err := r.Patch(ctx, scaled, client.MergeFrom(js)); err != nil {
+			log.Error(err, "scaling replicated job", "replicatedJob", rJob.Name)
+			return err
+		}
+		log.V(2).Info("scaled replicated job", "replicatedJob", rJob.Name, "from", rJob.Replicas, "to", scaled.Spec.ReplicatedJobs[i].Replicas)
+		js.Spec.ReplicatedJobs[i].Replicas = scaled.Spec.ReplicatedJobs[i].Replicas
+		js.ResourceVersion = scaled.ResourceVersion
+		js.Generation = scaled.Generation
+	}
+	return nil
+}
+
+// jobScaledDown returns true if the index of the Job is out of the replicas of its parent
+// ReplicatedJob, which happens after the ReplicatedJob is scaled down.
+func jobScaledDown(js *jobset.JobSet, job *batchv1.Job) bool {
+	jobIdx, err := strconv.Atoi(job.Labels[jobset.JobIndexKey])
+	if err != nil {
+		return false
+	}
+	for _, rJob := range js.Spec.ReplicatedJobs {
+		if rJob.Name == job.Labels[jobset.ReplicatedJobNameKey] {
+			return int32(jobIdx) >= rJob.Replicas
+		}
+	}
+	return false
+}
+
+// updateScaleStatus sets the observed replicas and the pod selector of the ReplicatedJob
+// scaled through the scale subresource of the JobSet.
+func updateScaleStatus(js *jobset.JobSet, ownedJobs *childJobs, updateStatusOpts *statusUpdateOpts) {
+	if js.Spec.Scale == nil {
+		return
+	}
+	var replicas int32
+	for _, jobs := range [][]*batchv1.Job{ownedJobs.active, ownedJobs.successful, ownedJobs.failed} {
+		for _, job := range jobs {
+			if job.Labels[jobset.ReplicatedJobNameKey] == js.Spec.Scale.ReplicatedJob {
+				replicas++
+			}
+		}
+	}
+	scaleStatus := &jobset.ReplicatedJobScaleStatus{
+		Replicas: replicas,
+		Selector: labels.SelectorFromSet(labels.Set{
+			jobset.JobSetNameKey:        js.Name,
+			jobset.ReplicatedJobNameKey: js.Spec.Scale.ReplicatedJob,
+		}).String(),
+	}
+	if js.Status.Scale != nil && *js.Status.Scale == *scaleStatus {
+		return
+	}
+	js.Status.Scale = scaleStatus
+	updateStatusOpts.shouldUpdate = true
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/utils/ptr"
+
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	testutils "sigs.k8s.io/jobset/pkg/util/testing"
+)
+
+func TestJobScaledDown(t *testing.T) {
+	var (
+		jobSetName = "test-jobset"
+		ns         = "default"
+	)
+
+	js := testutils.MakeJobSet(jobSetName, ns).
+		ReplicatedJob(testutils.MakeReplicatedJob("workers").Replicas(2).Obj()).
+		Obj()
+	makeJob := func(labels map[string]string) *batchv1.Job {
+		return testutils.MakeJob("job", ns).JobLabels(labels).Obj()
+	}
+
+	tests := []struct {
+		name string
+		job  *batchv1.Job
+		want bool
+	}{
+		{
+			name: "job index within replicas",
+			job:  makeJob(map[string]string{jobset.ReplicatedJobNameKey: "workers", jobset.JobIndexKey: "1"}),
+		},
+		{
+			name: "job index out of replicas",
+			job:  makeJob(map[string]string{jobset.ReplicatedJobNameKey: "workers", jobset.JobIndexKey: "2"}),
+			want: true,
+		},
+		{
+			name: "unknown replicated job",
+			job:  makeJob(map[string]string{jobset.ReplicatedJobNameKey: "unknown", jobset.JobIndexKey: "5"}),
+		},
+		{
+			name: "missing job index",
+			job:  makeJob(map[string]string{jobset.ReplicatedJobNameKey: "workers"}),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := jobScaledDown(js, tc.job); got != tc.want {
+				t.Errorf("unexpected jobScaledDown: want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestUpdateScaleStatus(t *testing.T) {
+	var (
+		jobSetName = "test-jobset"
+		ns         = "default"
+	)
+
+	workerJob := func(name string) *batchv1.Job {
+		return testutils.MakeJob(name, ns).JobLabels(map[string]string{jobset.ReplicatedJobNameKey: "workers"}).Obj()
+	}
+	driverJob := testutils.MakeJob("driver-0", ns).JobLabels(map[string]string{jobset.ReplicatedJobNameKey: "driver"}).Obj()
+	selector := "jobset.sigs.k8s.io/jobset-name=test-jobset,jobset.sigs.k8s.io/replicatedjob-name=workers"
+
+	tests := []struct {
+		name             string
+		scale            *jobset.ReplicatedJobScale
+		status           jobset.JobSetStatus
+		ownedJobs        *childJobs
+		wantScaleStatus  *jobset.ReplicatedJobScaleStatus
+		wantShouldUpdate bool
+	}{
+		{
+			name:      "scale not set",
+			ownedJobs: &childJobs{active: []*batchv1.Job{workerJob("workers-0")}},
+		},
+		{
+			name:  "jobs of the current run of the target replicated job are counted",
+			scale: &jobset.ReplicatedJobScale{ReplicatedJob: "workers", Replicas: ptr.To[int32](4)},
+			ownedJobs: &childJobs{
+				active:     []*batchv1.Job{workerJob("workers-0"), driverJob},
+				successful: []*batchv1.Job{workerJob("workers-1")},
+				failed:     []*batchv1.Job{workerJob("workers-2")},
+				previous:   []*batchv1.Job{workerJob("workers-4")},
+			},
+			wantScaleStatus:  &jobset.ReplicatedJobScaleStatus{Replicas: 3, Selector: selector},
+			wantShouldUpdate: true,
+		},
+		{
+			name:             "scale status unchanged",
+			scale:            &jobset.ReplicatedJobScale{ReplicatedJob: "workers", Replicas: ptr.To[int32](1)},
+			status:           jobset.JobSetStatus{Scale: &jobset.ReplicatedJobScaleStatus{Replicas: 1, Selector: selector}},
+			ownedJobs:        &childJobs{active: []*batchv1.Job{workerJob("workers-0")}},
+			wantScaleStatus:  &jobset.ReplicatedJobScaleStatus{Replicas: 1, Selector: selector},
+			wantShouldUpdate: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			js := testutils.MakeJobSet(jobSetName, ns).
+				Scale(tc.scale).
+				SetStatus(tc.status).
+				Obj()
+			opts := &statusUpdateOpts{}
+
+			updateScaleStatus(js, tc.ownedJobs, opts)
+			if diff := cmp.Diff(tc.wantScaleStatus, js.Status.Scale); diff != "" {
+				t.Errorf("unexpected scale status (-want/+got): %s", diff)
+			}
+			if opts.shouldUpdate != tc.wantShouldUpdate {
+				t.Errorf("unexpected shouldUpdate: want %v, got %v", tc.wantShouldUpdate, opts.shouldUpdate)
+			}
+		})
+	}
+}
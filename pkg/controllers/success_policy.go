@@ -17,6 +17,7 @@ import (
 	"slices"
 
 	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/utils/ptr"
 
 	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 )
@@ -24,13 +25,22 @@ import (
 // jobMatchesSuccessPolicy returns a boolean value indicating if the Job is part of a
 // ReplicatedJob that matches the JobSet's success policy.
 func jobMatchesSuccessPolicy(js *jobset.JobSet, job *batchv1.Job) bool {
-	return len(js.Spec.SuccessPolicy.TargetReplicatedJobs) == 0 || slices.Contains(js.Spec.SuccessPolicy.TargetReplicatedJobs, job.Labels[jobset.ReplicatedJobNameKey])
+	rjobName := job.Labels[jobset.ReplicatedJobNameKey]
+	if len(js.Spec.SuccessPolicy.TargetReplicatedJobs) == 0 {
+		rjobIdx := slices.IndexFunc(js.Spec.ReplicatedJobs, func(rjob jobset.ReplicatedJob) bool { return rjob.Name == rjobName })
+		return rjobIdx == -1 || !onFailureReplicatedJob(&js.Spec.ReplicatedJobs[rjobIdx])
+	}
+	return slices.Contains(js.Spec.SuccessPolicy.TargetReplicatedJobs, rjobName)
 }
 
 // replicatedJobMatchesSuccessPolicy returns a boolean value indicating if the ReplicatedJob
-// matches the JobSet's success policy.
+// matches the JobSet's success policy. ReplicatedJobs which only run when another ReplicatedJob
+// has failed only match a success policy targeting them explicitly.
 func replicatedJobMatchesSuccessPolicy(js *jobset.JobSet, rjob *jobset.ReplicatedJob) bool {
-	return len(js.Spec.SuccessPolicy.TargetReplicatedJobs) == 0 || slices.Contains(js.Spec.SuccessPolicy.TargetReplicatedJobs, rjob.Name)
+	if len(js.Spec.SuccessPolicy.TargetReplicatedJobs) == 0 {
+		return !onFailureReplicatedJob(rjob)
+	}
+	return slices.Contains(js.Spec.SuccessPolicy.TargetReplicatedJobs, rjob.Name)
 }
 
 // replicatedJobMatchesSuccessPolicy returns the number of jobs in the given slice `jobs`
@@ -53,6 +63,8 @@ func numJobsExpectedToSucceed(js *jobset.JobSet) int {
 	switch js.Spec.SuccessPolicy.Operator {
 	case jobset.OperatorAny:
 		total = 1
+	case jobset.OperatorAtLeast:
+		total = int(ptr.Deref(js.Spec.SuccessPolicy.MinSucceeded, 1))
 	case jobset.OperatorAll:
 		for _, rjob := range js.Spec.ReplicatedJobs {
 			if replicatedJobMatchesSuccessPolicy(js, &rjob) {
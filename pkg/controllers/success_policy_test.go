@@ -18,6 +18,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/utils/ptr"
 
 	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 	testutils "sigs.k8s.io/jobset/pkg/util/testing"
@@ -69,6 +70,21 @@ func TestJobMatchesSuccessPolicy(t *testing.T) {
 				Obj(),
 			expected: false,
 		},
+		{
+			name: "job of a replicated job running only on failure does not match empty TargetReplicatedJobs",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				SuccessPolicy(&jobset.SuccessPolicy{
+					TargetReplicatedJobs: []string{},
+				}).
+				ReplicatedJob(testutils.MakeReplicatedJob("test-replicated-job-1").Obj()).
+				ReplicatedJob(testutils.MakeReplicatedJob("test-replicated-job-2").
+					DependsOn([]jobset.DependsOn{{Name: "test-replicated-job-1", Status: jobset.DependencyFailed}}).
+					Obj()).Obj(),
+			job: testutils.MakeJob(jobName, ns).
+				JobLabels(map[string]string{jobset.ReplicatedJobNameKey: "test-replicated-job-2"}).
+				Obj(),
+			expected: false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -268,6 +284,34 @@ func TestNumJobsExpectedToSucceed(t *testing.T) {
 					Replicas(3).Obj()).Obj(),
 			expected: 4,
 		},
+		{
+			name: "at least min succeeded jobs fulfill success policy",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				SuccessPolicy(&jobset.SuccessPolicy{
+					Operator:             jobset.OperatorAtLeast,
+					TargetReplicatedJobs: []string{"test-replicated-job-1"},
+					MinSucceeded:         ptr.To[int32](2),
+				}).
+				ReplicatedJob(testutils.MakeReplicatedJob("test-replicated-job-1").
+					Replicas(5).Obj()).
+				ReplicatedJob(testutils.MakeReplicatedJob("test-replicated-job-2").
+					Replicas(2).Obj()).Obj(),
+			expected: 2,
+		},
+		{
+			name: "replicated jobs running only on failure don't match a success policy without targets",
+			js: testutils.MakeJobSet(jobSetName, ns).
+				SuccessPolicy(&jobset.SuccessPolicy{
+					Operator: jobset.OperatorAll,
+				}).
+				ReplicatedJob(testutils.MakeReplicatedJob("test-replicated-job-1").
+					Replicas(2).Obj()).
+				ReplicatedJob(testutils.MakeReplicatedJob("test-replicated-job-2").
+					Replicas(1).
+					DependsOn([]jobset.DependsOn{{Name: "test-replicated-job-1", Status: jobset.DependencyFailed}}).
+					Obj()).Obj(),
+			expected: 2,
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,69 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	"sigs.k8s.io/jobset/pkg/constants"
+)
+
+// topologyEnvEnabled returns true if the topology of the JobSet should be injected as environment
+// variables into the child Jobs of the given ReplicatedJob.
+func topologyEnvEnabled(js *jobset.JobSet, rjob *jobset.ReplicatedJob) bool {
+	if rjob.InjectTopologyEnv != nil {
+		return *rjob.InjectTopologyEnv
+	}
+	return ptr.Deref(js.Spec.InjectTopologyEnv, false)
+}
+
+// topologyEnv returns the environment variables describing the topology of the JobSet for a child
+// Job, read from the labels set on its pod template by labelAndAnnotateObject.
+func topologyEnv(js *jobset.JobSet, podLabels map[string]string) []corev1.EnvVar {
+	env := []corev1.EnvVar{
+		{Name: jobset.JobSetNameEnv, Value: podLabels[jobset.JobSetNameKey]},
+		{Name: jobset.ReplicatedJobNameEnv, Value: podLabels[jobset.ReplicatedJobNameKey]},
+		{Name: jobset.JobIndexEnv, Value: podLabels[jobset.JobIndexKey]},
+		{Name: jobset.JobGlobalIndexEnv, Value: podLabels[jobset.JobGlobalIndexKey]},
+		{Name: jobset.GlobalReplicasEnv, Value: podLabels[jobset.GlobalReplicasKey]},
+		{Name: jobset.GroupNameEnv, Value: podLabels[jobset.GroupNameKey]},
+		{Name: jobset.GroupReplicasEnv, Value: podLabels[jobset.GroupReplicasKey]},
+		{Name: jobset.GroupJobIndexEnv, Value: podLabels[jobset.JobGroupIndexKey]},
+		{Name: jobset.RestartAttemptEnv, Value: podLabels[constants.RestartsKey]},
+	}
+	if js.Spec.Coordinator != nil {
+		env = append(env, corev1.EnvVar{Name: jobset.CoordinatorAddressEnv, Value: CoordinatorEndpoint(js)})
+	}
+	return env
+}
+
+// InjectEnv adds the given environment variables to every container and init container of the
+// pod spec. Environment variables already defined by a container are not overridden.
+func InjectEnv(podSpec *corev1.PodSpec, env []corev1.EnvVar) {
+	for _, containers := range [][]corev1.Container{podSpec.InitContainers, podSpec.Containers} {
+		for i := range containers {
+			container := &containers[i]
+			for _, envVar := range env {
+				if slices.ContainsFunc(container.Env, func(e corev1.EnvVar) bool { return e.Name == envVar.Name }) {
+					continue
+				}
+				container.Env = append(container.Env, envVar)
+			}
+		}
+	}
+}
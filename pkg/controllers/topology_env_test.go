@@ -0,0 +1,155 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	testutils "sigs.k8s.io/jobset/pkg/util/testing"
+)
+
+func TestTopologyEnv(t *testing.T) {
+	var (
+		jobSetName = "test-jobset"
+		ns         = "default"
+	)
+
+	podSpec := corev1.PodSpec{
+		InitContainers: []corev1.Container{{Name: "init"}},
+		Containers: []corev1.Container{
+			{Name: "trainer"},
+			{Name: "sidecar", Env: []corev1.EnvVar{{Name: jobset.JobIndexEnv, Value: "custom"}}},
+		},
+	}
+	makeJobSet := func() *testutils.JobSetWrapper {
+		return testutils.MakeJobSet(jobSetName, ns).
+			EnableDNSHostnames(true).
+			ReplicatedJob(testutils.MakeReplicatedJob("driver").
+				Job(testutils.MakeJobTemplate("driver", ns).PodSpec(podSpec).Obj()).
+				GroupName("default").
+				Replicas(1).
+				Obj()).
+			ReplicatedJob(testutils.MakeReplicatedJob("workers").
+				Job(testutils.MakeJobTemplate("workers", ns).PodSpec(podSpec).Obj()).
+				GroupName("default").
+				Replicas(2).
+				Obj())
+	}
+	workerEnv := []corev1.EnvVar{
+		{Name: jobset.JobSetNameEnv, Value: jobSetName},
+		{Name: jobset.ReplicatedJobNameEnv, Value: "workers"},
+		{Name: jobset.JobIndexEnv, Value: "1"},
+		{Name: jobset.JobGlobalIndexEnv, Value: "2"},
+		{Name: jobset.GlobalReplicasEnv, Value: "3"},
+		{Name: jobset.GroupNameEnv, Value: "default"},
+		{Name: jobset.GroupReplicasEnv, Value: "3"},
+		{Name: jobset.GroupJobIndexEnv, Value: "2"},
+		{Name: jobset.RestartAttemptEnv, Value: "0"},
+	}
+	coordinatorEnv := corev1.EnvVar{Name: jobset.CoordinatorAddressEnv, Value: "test-jobset-driver-0-0.test-jobset"}
+
+	tests := []struct {
+		name string
+		js   *jobset.JobSet
+		// wantEnv is the environment of each container of the second worker Job, keyed by container name.
+		wantEnv map[string][]corev1.EnvVar
+	}{
+		{
+			name: "topology env not injected by default",
+			js:   makeJobSet().Obj(),
+			wantEnv: map[string][]corev1.EnvVar{
+				"init":    nil,
+				"trainer": nil,
+				"sidecar": {{Name: jobset.JobIndexEnv, Value: "custom"}},
+			},
+		},
+		{
+			name: "topology env injected without overriding container env",
+			js:   makeJobSet().InjectTopologyEnv(true).Obj(),
+			wantEnv: map[string][]corev1.EnvVar{
+				"init":    workerEnv,
+				"trainer": workerEnv,
+				"sidecar": append([]corev1.EnvVar{{Name: jobset.JobIndexEnv, Value: "custom"}},
+					workerEnv[0], workerEnv[1], workerEnv[3], workerEnv[4], workerEnv[5], workerEnv[6], workerEnv[7], workerEnv[8]),
+			},
+		},
+		{
+			name: "topology env with coordinator address",
+			js: makeJobSet().
+				InjectTopologyEnv(true).
+				Coordinator(&jobset.Coordinator{ReplicatedJob: "driver"}).
+				Obj(),
+			wantEnv: map[string][]corev1.EnvVar{
+				"init":    append(append([]corev1.EnvVar{}, workerEnv...), coordinatorEnv),
+				"trainer": append(append([]corev1.EnvVar{}, workerEnv...), coordinatorEnv),
+				"sidecar": append([]corev1.EnvVar{{Name: jobset.JobIndexEnv, Value: "custom"}},
+					workerEnv[0], workerEnv[1], workerEnv[3], workerEnv[4], workerEnv[5], workerEnv[6], workerEnv[7], workerEnv[8], coordinatorEnv),
+			},
+		},
+		{
+			name: "topology env disabled for the replicated job",
+			js: func() *jobset.JobSet {
+				js := makeJobSet().InjectTopologyEnv(true).Obj()
+				js.Spec.ReplicatedJobs[1].InjectTopologyEnv = ptr.To(false)
+				return js
+			}(),
+			wantEnv: map[string][]corev1.EnvVar{
+				"init":    nil,
+				"trainer": nil,
+				"sidecar": {{Name: jobset.JobIndexEnv, Value: "custom"}},
+			},
+		},
+		{
+			name: "topology env enabled for the replicated job only",
+			js: func() *jobset.JobSet {
+				js := makeJobSet().Obj()
+				js.Spec.ReplicatedJobs[1] = testutils.MakeReplicatedJob("workers").
+					Job(testutils.MakeJobTemplate("workers", ns).PodSpec(podSpec).Obj()).
+					GroupName("default").
+					Replicas(2).
+					InjectTopologyEnv(true).
+					Obj()
+				return js
+			}(),
+			wantEnv: map[string][]corev1.EnvVar{
+				"init":    workerEnv,
+				"trainer": workerEnv,
+				"sidecar": append([]corev1.EnvVar{{Name: jobset.JobIndexEnv, Value: "custom"}},
+					workerEnv[0], workerEnv[1], workerEnv[3], workerEnv[4], workerEnv[5], workerEnv[6], workerEnv[7], workerEnv[8]),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			job := constructJob(tc.js, &tc.js.Spec.ReplicatedJobs[1], 1)
+			gotEnv := map[string][]corev1.EnvVar{}
+			for _, container := range append(job.Spec.Template.Spec.InitContainers, job.Spec.Template.Spec.Containers...) {
+				gotEnv[container.Name] = container.Env
+			}
+			if diff := cmp.Diff(tc.wantEnv, gotEnv); diff != "" {
+				t.Errorf("unexpected container env (-want/+got): %s", diff)
+			}
+			// The pod template of the ReplicatedJob must not be mutated.
+			if env := tc.js.Spec.ReplicatedJobs[1].Template.Spec.Template.Spec.Containers[0].Env; env != nil {
+				t.Errorf("unexpected env in the replicated job template: %v", env)
+			}
+		})
+	}
+}
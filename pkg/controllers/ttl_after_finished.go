@@ -95,6 +95,10 @@ func getJobSetFinishAndExpireTime(js *jobset.JobSet) (finishAt, expireAt *time.T
 
 // jobSetFinishTime takes an already finished JobSet and returns the time it finishes.
 func jobSetFinishTime(finishedJobSet *jobset.JobSet) (metav1.Time, error) {
+	if finishedJobSet.Status.CompletionTime != nil {
+		return *finishedJobSet.Status.CompletionTime, nil
+	}
+	// Fall back to the finished condition for JobSets which finished before the completion time was tracked.
 	for _, c := range finishedJobSet.Status.Conditions {
 		if (c.Type == string(jobset.JobSetCompleted) || c.Type == string(jobset.JobSetFailed)) && c.Status == metav1.ConditionTrue {
 			finishAt := c.LastTransitionTime
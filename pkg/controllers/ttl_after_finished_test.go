@@ -270,6 +270,16 @@ func TestTimeLeft(t *testing.T) {
 			now:              &now.Time,
 			expectedTimeLeft: ptr.To(5 * time.Second),
 		},
+		{
+			name: "jobset completion time takes precedence over the condition, 15s TTL",
+			jobset: testutils.MakeJobSet(jobSetName, ns).TTLSecondsAfterFinished(15).
+				SetStatus(jobset.JobSetStatus{
+					CompletionTime: ptr.To(metav1.NewTime(now.Add(-10 * time.Second))),
+				}).
+				CompletedCondition(now).Obj(),
+			now:              &now.Time,
+			expectedTimeLeft: ptr.To(5 * time.Second),
+		},
 	}
 
 	for _, tc := range tests {
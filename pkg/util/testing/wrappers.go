@@ -152,12 +152,48 @@ func (j *JobSetWrapper) PublishNotReadyAddresses(val bool) *JobSetWrapper {
 	return j
 }
 
+// NetworkGroup sets the value of JobSet.Network.Group.
+func (j *JobSetWrapper) NetworkGroup(val string) *JobSetWrapper {
+	j.Spec.Network.Group = val
+	return j
+}
+
+// NetworkPorts sets the value of JobSet.Network.Ports.
+func (j *JobSetWrapper) NetworkPorts(ports ...jobset.NetworkPort) *JobSetWrapper {
+	j.Spec.Network.Ports = ports
+	return j
+}
+
+// NetworkIsolation sets the value of JobSet.Network.Isolation.
+func (j *JobSetWrapper) NetworkIsolation(isolation *jobset.NetworkIsolation) *JobSetWrapper {
+	j.Spec.Network.Isolation = isolation
+	return j
+}
+
+// InjectTopologyEnv sets the value of JobSet.Spec.InjectTopologyEnv.
+func (j *JobSetWrapper) InjectTopologyEnv(val bool) *JobSetWrapper {
+	j.Spec.InjectTopologyEnv = ptr.To(val)
+	return j
+}
+
+// Framework sets the value of JobSet.Spec.Framework.
+func (j *JobSetWrapper) Framework(framework *jobset.Framework) *JobSetWrapper {
+	j.Spec.Framework = framework
+	return j
+}
+
 // TTLSecondsAfterFinished sets the value of JobSet.Spec.TTLSecondsAfterFinished
 func (j *JobSetWrapper) TTLSecondsAfterFinished(seconds int32) *JobSetWrapper {
 	j.Spec.TTLSecondsAfterFinished = &seconds
 	return j
 }
 
+// Scale sets the value of JobSet.Spec.Scale
+func (j *JobSetWrapper) Scale(scale *jobset.ReplicatedJobScale) *JobSetWrapper {
+	j.Spec.Scale = scale
+	return j
+}
+
 // CompletedCondition adds a JobSetCompleted condition to the JobSet Status.
 func (j *JobSetWrapper) CompletedCondition(completedAt metav1.Time) *JobSetWrapper {
 	c := metav1.Condition{Type: string(jobset.JobSetCompleted), Status: metav1.ConditionTrue, LastTransitionTime: completedAt}
@@ -234,6 +270,30 @@ func (r *ReplicatedJobWrapper) DependsOn(dependsOn []jobset.DependsOn) *Replicat
 	return r
 }
 
+// Suspend sets the value of the ReplicatedJob.Suspend.
+func (r *ReplicatedJobWrapper) Suspend(suspend bool) *ReplicatedJobWrapper {
+	r.ReplicatedJob.Suspend = ptr.To(suspend)
+	return r
+}
+
+// Network sets the value of the ReplicatedJob.Network.
+func (r *ReplicatedJobWrapper) Network(network *jobset.ReplicatedJobNetwork) *ReplicatedJobWrapper {
+	r.ReplicatedJob.Network = network
+	return r
+}
+
+// InjectTopologyEnv sets the value of the ReplicatedJob.InjectTopologyEnv.
+func (r *ReplicatedJobWrapper) InjectTopologyEnv(val bool) *ReplicatedJobWrapper {
+	r.ReplicatedJob.InjectTopologyEnv = ptr.To(val)
+	return r
+}
+
+// Framework sets the value of the ReplicatedJob.Framework.
+func (r *ReplicatedJobWrapper) Framework(framework *jobset.Framework) *ReplicatedJobWrapper {
+	r.ReplicatedJob.Framework = framework
+	return r
+}
+
 // Obj returns the inner ReplicatedJob.
 func (r *ReplicatedJobWrapper) Obj() jobset.ReplicatedJob {
 	return r.ReplicatedJob
@@ -422,6 +482,12 @@ func (j *JobWrapper) Succeeded(succeeded int32) *JobWrapper {
 	return j
 }
 
+// Failed sets the job status failed.
+func (j *JobWrapper) Failed(failed int32) *JobWrapper {
+	j.Status.Failed = failed
+	return j
+}
+
 // Active sets the job status active.
 func (j *JobWrapper) Active(active int32) *JobWrapper {
 	j.Status.Active = active
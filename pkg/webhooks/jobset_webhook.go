@@ -25,8 +25,11 @@ import (
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -37,6 +40,7 @@ import (
 
 	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 	"sigs.k8s.io/jobset/pkg/controllers"
+	"sigs.k8s.io/jobset/pkg/features"
 	"sigs.k8s.io/jobset/pkg/util/placement"
 )
 
@@ -63,6 +67,10 @@ const (
 	// Error message returned by JobSet validation if the network subdomain
 	// will be longer than 63 characters.
 	subdomainTooLongErrMsg = ".spec.network.subdomain is too long, must be less than 63 characters"
+
+	// Error message returned by JobSet validation if the network subdomain
+	// of a replicated job will be longer than 63 characters.
+	replicatedJobSubdomainTooLongErrMsg = ".spec.replicatedJobs[].network.subdomain is too long, must be less than 63 characters"
 )
 
 // validOnJobFailureReasons stores supported values of the reason field of the condition of
@@ -76,6 +84,14 @@ var validOnJobFailureReasons = []string{
 	batchv1.JobReasonPodFailurePolicy,
 }
 
+// validFailurePolicyActions stores supported values of the action field of a failure policy rule.
+var validFailurePolicyActions = []jobset.FailurePolicyAction{
+	jobset.FailJobSet,
+	jobset.RestartJobSet,
+	jobset.RestartJobSetAndIgnoreMaxRestarts,
+	jobset.SuspendJobSet,
+}
+
 //+kubebuilder:webhook:path=/mutate-jobset-x-k8s-io-v1alpha2-jobset,mutating=true,failurePolicy=fail,sideEffects=None,groups=jobset.x-k8s.io,resources=jobsets,verbs=create,versions=v1alpha2,name=mjobset.kb.io,admissionReviewVersions=v1
 
 // jobSetWebhook for defaulting and admission.
@@ -139,6 +155,13 @@ func (j *jobSetWebhook) Default(ctx context.Context, obj runtime.Object) error {
 		js.Spec.Network.PublishNotReadyAddresses = ptr.To(true)
 	}
 
+	// Default the replicas of the scale subresource to the replicas of the target replicatedJob.
+	if js.Spec.Scale != nil && js.Spec.Scale.Replicas == nil {
+		if rJob := replicatedJobByName(js, js.Spec.Scale.ReplicatedJob); rJob != nil {
+			js.Spec.Scale.Replicas = ptr.To(rJob.Replicas)
+		}
+	}
+
 	// Apply the default failure policy rule name policy.
 	if js.Spec.FailurePolicy != nil {
 		for i := range js.Spec.FailurePolicy.Rules {
@@ -162,27 +185,25 @@ func (j *jobSetWebhook) ValidateCreate(ctx context.Context, obj runtime.Object)
 	}
 
 	var allErrs []error
-	// Validate that depends On can't be set for the first replicated job.
-	if len(js.Spec.ReplicatedJobs) > 0 && js.Spec.ReplicatedJobs[0].DependsOn != nil {
-		allErrs = append(allErrs, fmt.Errorf("DependsOn can't be set for the first ReplicatedJob"))
-	}
 
 	// Ensure that a provided subdomain is a valid DNS name
 	if js.Spec.Network != nil && js.Spec.Network.Subdomain != "" {
 		fieldPath := field.NewPath("spec", "network", "subdomain")
-		// This can return 1 or 2 errors, validating max length and format
-		for _, errMessage := range validation.IsDNS1123Subdomain(js.Spec.Network.Subdomain) {
-			allErrs = append(allErrs, field.Invalid(fieldPath, js.Spec.Network.Subdomain, errMessage))
-		}
+		allErrs = append(allErrs, validateSubdomain(fieldPath, js.Spec.Network.Subdomain, subdomainTooLongErrMsg)...)
+	}
 
-		// Since subdomain name is also used as service name, it must adhere to RFC 1035 as well.
-		for _, errMessage := range validation.IsDNS1035Label(js.Spec.Network.Subdomain) {
-			if strings.Contains(errMessage, dns1035MaxLengthExceededErrorMsg) {
-				errMessage = subdomainTooLongErrMsg
-			}
+	// Validate the network overrides of the replicated jobs.
+	allErrs = append(allErrs, validateReplicatedJobNetworks(js)...)
 
-			allErrs = append(allErrs, field.Invalid(fieldPath, js.Spec.Network.Subdomain, errMessage))
-		}
+	// Validate the framework presets, if any.
+	allErrs = append(allErrs, validateFrameworks(js)...)
+
+	// Validate the network group shared with other JobSets, if any.
+	allErrs = append(allErrs, j.validateNetworkGroup(ctx, js)...)
+
+	// Validate the ports exposed by the headless services.
+	if js.Spec.Network != nil {
+		allErrs = append(allErrs, validateNetworkPorts(field.NewPath("spec", "network", "ports"), js.Spec.Network.Ports)...)
 	}
 
 	// Validate the managedBy field used for multi-kueue support.
@@ -204,14 +225,6 @@ func (j *jobSetWebhook) ValidateCreate(ctx context.Context, obj runtime.Object)
 		fieldPath := field.NewPath("spec", "replicatedJobs").Index(rJobIdx)
 		rJobNames.Insert(rJob.Name)
 
-		var parallelism int32 = 1
-		if rJob.Template.Spec.Parallelism != nil {
-			parallelism = *rJob.Template.Spec.Parallelism
-		}
-		if int64(parallelism)*int64(rJob.Replicas) > math.MaxInt32 {
-			allErrs = append(allErrs, fmt.Errorf("the product of replicas and parallelism must not exceed %d for replicatedJob '%s'", math.MaxInt32, rJob.Name))
-		}
-
 		// Check that the group name is DNS 1035 compliant.
 		for _, errMessage := range validation.IsDNS1035Label(rJob.GroupName) {
 			if strings.Contains(errMessage, dns1035MaxLengthExceededErrorMsg) {
@@ -219,44 +232,15 @@ func (j *jobSetWebhook) ValidateCreate(ctx context.Context, obj runtime.Object)
 			}
 			allErrs = append(allErrs, field.Invalid(fieldPath.Child("groupName"), rJob.GroupName, errMessage))
 		}
-		// Check that the generated job names for this replicated job will be DNS 1035 compliant.
-		// Use the largest job index as it will have the longest name.
-		longestJobName := placement.GenJobName(js.Name, rJob.Name, int(rJob.Replicas-1))
-		for _, errMessage := range validation.IsDNS1035Label(longestJobName) {
-			if strings.Contains(errMessage, dns1035MaxLengthExceededErrorMsg) {
-				errMessage = jobNameTooLongErrorMsg
-			}
-			allErrs = append(allErrs, field.Invalid(fieldPath.Child("name"), longestJobName, errMessage))
-		}
-		// Check that the generated pod names for the replicated job is DNS 1035 compliant.
-		isIndexedJob := rJob.Template.Spec.CompletionMode != nil && *rJob.Template.Spec.CompletionMode == batchv1.IndexedCompletion
-		if isIndexedJob && rJob.Template.Spec.Completions != nil {
-			maxJobIndex := strconv.Itoa(int(rJob.Replicas - 1))
-			maxPodIndex := strconv.Itoa(int(*rJob.Template.Spec.Completions - 1))
-			// Add 5 char suffix to the deterministic part of the pod name to validate the full pod name is compliant.
-			longestPodName := placement.GenPodName(js.Name, rJob.Name, maxJobIndex, maxPodIndex) + "-abcde"
-			for _, errMessage := range validation.IsDNS1035Label(longestPodName) {
-				if strings.Contains(errMessage, dns1035MaxLengthExceededErrorMsg) {
-					errMessage = podNameTooLongErrorMsg
-				}
-				allErrs = append(allErrs, field.Invalid(fieldPath.Child("name"), longestJobName, errMessage))
-			}
-		}
 
-		// Check that DependsOn references the previous ReplicatedJob.
-		for _, dependOnItem := range rJob.DependsOn {
-			if !rJobNames.Has(dependOnItem.Name) {
-				allErrs = append(allErrs, fmt.Errorf("replicatedJob: %s cannot depend on replicatedJob: %s", rJob.Name, dependOnItem.Name))
-			}
-		}
+		allErrs = append(allErrs, validateReplicas(js, rJobIdx)...)
 	}
 
-	// Validate the success policy's target replicated jobs are valid.
-	for _, rJobName := range js.Spec.SuccessPolicy.TargetReplicatedJobs {
-		if !rJobNames.Has(rJobName) {
-			allErrs = append(allErrs, fmt.Errorf("invalid replicatedJob name '%s' does not appear in .spec.ReplicatedJobs", rJobName))
-		}
-	}
+	// Validate the DependsOn graph of the replicated jobs.
+	allErrs = append(allErrs, validateDependsOn(js.Spec.ReplicatedJobs, rJobNames)...)
+
+	// Validate success policy
+	allErrs = append(allErrs, validateSuccessPolicy(js, rJobNames)...)
 
 	// Validate failure policy
 	if js.Spec.FailurePolicy != nil {
@@ -264,10 +248,21 @@ func (j *jobSetWebhook) ValidateCreate(ctx context.Context, obj runtime.Object)
 		allErrs = append(allErrs, failurePolicyErrors...)
 	}
 
+	// Validate in-place restart, if used.
+	if js.Spec.FailurePolicy != nil && js.Spec.FailurePolicy.RestartStrategy == jobset.InPlaceRestart {
+		allErrs = append(allErrs, validateInPlaceRestart(js)...)
+	}
+
 	// Validate coordinator, if set.
 	if js.Spec.Coordinator != nil {
 		allErrs = append(allErrs, validateCoordinator(js))
 		allErrs = append(allErrs, validateCoordinatorLabelValue(js))
+		allErrs = append(allErrs, validateCoordinatorSvc(js)...)
+	}
+
+	// Validate scale, if set.
+	if js.Spec.Scale != nil {
+		allErrs = append(allErrs, validateScale(js, nil).ToAggregate())
 	}
 	return nil, errors.Join(allErrs...)
 }
@@ -299,10 +294,54 @@ func (j *jobSetWebhook) ValidateUpdate(ctx context.Context, old, newObj runtime.
 		}
 	}
 
+	var allErrs []error
+	replicasUpdated := false
+	for index := range mungedSpec.ReplicatedJobs {
+		if index >= len(oldJS.Spec.ReplicatedJobs) {
+			continue
+		}
+		// Allow replicatedJobs to be suspended or resumed individually.
+		mungedSpec.ReplicatedJobs[index].Suspend = oldJS.Spec.ReplicatedJobs[index].Suspend
+
+		// Allow replicas to be mutated, so JobSets can be scaled up or down while running.
+		if mungedSpec.ReplicatedJobs[index].Replicas == oldJS.Spec.ReplicatedJobs[index].Replicas {
+			continue
+		}
+		mungedSpec.ReplicatedJobs[index].Replicas = oldJS.Spec.ReplicatedJobs[index].Replicas
+		replicasUpdated = true
+		// The global and group indexes of the jobs of a replicatedJob are offset by the replicas of the
+		// replicatedJobs before it, so only the replicas of the last replicatedJob can be updated
+		// without changing the indexes of the existing jobs.
+		if index != len(js.Spec.ReplicatedJobs)-1 {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "replicatedJobs").Index(index).Child("replicas"), "only the replicas of the last replicated job can be updated"))
+			continue
+		}
+		allErrs = append(allErrs, validateReplicas(js, index)...)
+	}
+	// The validations depending on the number of replicas are performed again for the new replicas.
+	if replicasUpdated {
+		if js.Spec.SuccessPolicy != nil {
+			rJobNames := sets.New[string]()
+			for _, rJob := range js.Spec.ReplicatedJobs {
+				rJobNames.Insert(rJob.Name)
+			}
+			allErrs = append(allErrs, validateSuccessPolicy(js, rJobNames)...)
+		}
+		if js.Spec.Coordinator != nil {
+			allErrs = append(allErrs, validateCoordinator(js))
+		}
+	}
+
 	// Note that SucccessPolicy and failurePolicy are made immutable via CEL.
 	errs := apivalidation.ValidateImmutableField(mungedSpec.ReplicatedJobs, oldJS.Spec.ReplicatedJobs, field.NewPath("spec").Child("replicatedJobs"))
 	errs = append(errs, apivalidation.ValidateImmutableField(mungedSpec.ManagedBy, oldJS.Spec.ManagedBy, field.NewPath("spec").Child("managedBy"))...)
-	return nil, errs.ToAggregate()
+	if js.Spec.Scale != nil {
+		errs = append(errs, validateScale(js, oldJS)...)
+	}
+	for _, err := range errs {
+		allErrs = append(allErrs, err)
+	}
+	return nil, utilerrors.NewAggregate(allErrs)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
@@ -320,6 +359,167 @@ const (
 // ruleNameRegexp is the regular expression that failure policy rules must match.
 var ruleNameRegexp = regexp.MustCompile(ruleNameFmt)
 
+// validateReplicas validates the following for the replicas of the replicated job at the given index:
+// 1. the product of replicas and parallelism does not overflow.
+// 2. the generated job and pod names are DNS 1035 compliant.
+func validateReplicas(js *jobset.JobSet, rJobIdx int) []error {
+	var allErrs []error
+	rJob := js.Spec.ReplicatedJobs[rJobIdx]
+	fieldPath := field.NewPath("spec", "replicatedJobs").Index(rJobIdx)
+
+	var parallelism int32 = 1
+	if rJob.Template.Spec.Parallelism != nil {
+		parallelism = *rJob.Template.Spec.Parallelism
+	}
+	if int64(parallelism)*int64(rJob.Replicas) > math.MaxInt32 {
+		allErrs = append(allErrs, fmt.Errorf("the product of replicas and parallelism must not exceed %d for replicatedJob '%s'", math.MaxInt32, rJob.Name))
+	}
+
+	// Check that the generated job names for this replicated job will be DNS 1035 compliant.
+	// Use the largest job index as it will have the longest name.
+	longestJobName := placement.GenJobName(js.Name, rJob.Name, int(rJob.Replicas-1))
+	for _, errMessage := range validation.IsDNS1035Label(longestJobName) {
+		if strings.Contains(errMessage, dns1035MaxLengthExceededErrorMsg) {
+			errMessage = jobNameTooLongErrorMsg
+		}
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("name"), longestJobName, errMessage))
+	}
+	// Check that the generated pod names for the replicated job is DNS 1035 compliant.
+	isIndexedJob := rJob.Template.Spec.CompletionMode != nil && *rJob.Template.Spec.CompletionMode == batchv1.IndexedCompletion
+	if isIndexedJob && rJob.Template.Spec.Completions != nil {
+		maxJobIndex := strconv.Itoa(int(rJob.Replicas - 1))
+		maxPodIndex := strconv.Itoa(int(*rJob.Template.Spec.Completions - 1))
+		// Add 5 char suffix to the deterministic part of the pod name to validate the full pod name is compliant.
+		longestPodName := placement.GenPodName(js.Name, rJob.Name, maxJobIndex, maxPodIndex) + "-abcde"
+		for _, errMessage := range validation.IsDNS1035Label(longestPodName) {
+			if strings.Contains(errMessage, dns1035MaxLengthExceededErrorMsg) {
+				errMessage = podNameTooLongErrorMsg
+			}
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("name"), longestJobName, errMessage))
+		}
+	}
+	return allErrs
+}
+
+// validateScale validates the following:
+// 1. the target replicated job of the scale subresource exists and is the last replicated job,
+// since only the replicas of the last replicated job can be updated.
+// 2. scale.replicas matches the replicas of the target replicated job. On update, only direct
+// updates of the replicas of the target replicated job are checked, since scale.replicas is
+// updated through the scale subresource and then applied to the target replicated job by the controller.
+func validateScale(js, oldJS *jobset.JobSet) field.ErrorList {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec", "scale")
+	rJob := replicatedJobByName(js, js.Spec.Scale.ReplicatedJob)
+	if rJob == nil {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("replicatedJob"), js.Spec.Scale.ReplicatedJob, "must be the name of a replicated job in .spec.replicatedJobs"))
+		return allErrs
+	}
+	if rJob.Name != js.Spec.ReplicatedJobs[len(js.Spec.ReplicatedJobs)-1].Name {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("replicatedJob"), js.Spec.Scale.ReplicatedJob, "must be the name of the last replicated job in .spec.replicatedJobs"))
+		return allErrs
+	}
+	if js.Spec.Scale.Replicas == nil || *js.Spec.Scale.Replicas == rJob.Replicas {
+		return allErrs
+	}
+	if oldJS != nil {
+		if oldRJob := replicatedJobByName(oldJS, rJob.Name); oldRJob != nil && oldRJob.Replicas == rJob.Replicas {
+			return allErrs
+		}
+	}
+	allErrs = append(allErrs, field.Invalid(fieldPath.Child("replicas"), *js.Spec.Scale.Replicas, fmt.Sprintf("must match the replicas of replicated job '%s' (%d)", rJob.Name, rJob.Replicas)))
+	return allErrs
+}
+
+// validateDependsOn validates the following:
+// 1. DependsOn references existing replicated jobs.
+// 2. the DependsOn graph of the replicated jobs is acyclic.
+func validateDependsOn(rJobs []jobset.ReplicatedJob, rJobNames sets.Set[string]) []error {
+	var allErrs []error
+	rJobIndices := make(map[string]int, len(rJobs))
+	for rJobIdx, rJob := range rJobs {
+		rJobIndices[rJob.Name] = rJobIdx
+		for _, dependOnItem := range rJob.DependsOn {
+			if !rJobNames.Has(dependOnItem.Name) {
+				allErrs = append(allErrs, fmt.Errorf("replicatedJob: %s cannot depend on replicatedJob: %s", rJob.Name, dependOnItem.Name))
+			}
+		}
+	}
+
+	// Detect cycles with a depth-first search, reporting each cycle on the DependsOn item closing it.
+	const (
+		unvisited = iota
+		inProgress
+		done
+	)
+	state := make(map[string]int, len(rJobs))
+	var path []string
+	var visit func(rJobIdx int)
+	visit = func(rJobIdx int) {
+		rJob := rJobs[rJobIdx]
+		state[rJob.Name] = inProgress
+		path = append(path, rJob.Name)
+		for dependOnIdx, dependOnItem := range rJob.DependsOn {
+			dependOnRJobIdx, ok := rJobIndices[dependOnItem.Name]
+			if !ok {
+				continue
+			}
+			switch state[dependOnItem.Name] {
+			case inProgress:
+				cycle := append(slices.Clone(path[slices.Index(path, dependOnItem.Name):]), dependOnItem.Name)
+				fieldPath := field.NewPath("spec", "replicatedJobs").Index(rJobIdx).Child("dependsOn").Index(dependOnIdx).Child("name")
+				allErrs = append(allErrs, field.Invalid(fieldPath, dependOnItem.Name, fmt.Sprintf("dependency cycle: %s", strings.Join(cycle, " -> "))))
+			case unvisited:
+				visit(dependOnRJobIdx)
+			}
+		}
+		path = path[:len(path)-1]
+		state[rJob.Name] = done
+	}
+	for rJobIdx, rJob := range rJobs {
+		if state[rJob.Name] == unvisited {
+			visit(rJobIdx)
+		}
+	}
+	return allErrs
+}
+
+// validateSuccessPolicy validates the following:
+// 1. the target replicated jobs are valid.
+// 2. minSucceeded is set if and only if the AtLeast operator is used.
+// 3. minSucceeded does not exceed the number of replicas of the target replicated jobs.
+func validateSuccessPolicy(js *jobset.JobSet, rJobNames sets.Set[string]) []error {
+	var allErrs []error
+	successPolicy := js.Spec.SuccessPolicy
+	for _, rJobName := range successPolicy.TargetReplicatedJobs {
+		if !rJobNames.Has(rJobName) {
+			allErrs = append(allErrs, fmt.Errorf("invalid replicatedJob name '%s' does not appear in .spec.ReplicatedJobs", rJobName))
+		}
+	}
+
+	fieldPath := field.NewPath("spec", "successPolicy", "minSucceeded")
+	if successPolicy.Operator != jobset.OperatorAtLeast {
+		if successPolicy.MinSucceeded != nil {
+			allErrs = append(allErrs, field.Forbidden(fieldPath, fmt.Sprintf("minSucceeded can only be set with the %s operator", jobset.OperatorAtLeast)))
+		}
+		return allErrs
+	}
+	if successPolicy.MinSucceeded == nil {
+		allErrs = append(allErrs, field.Required(fieldPath, fmt.Sprintf("minSucceeded must be set with the %s operator", jobset.OperatorAtLeast)))
+		return allErrs
+	}
+	var targetReplicas int32
+	for _, rJob := range js.Spec.ReplicatedJobs {
+		if len(successPolicy.TargetReplicatedJobs) == 0 || slices.Contains(successPolicy.TargetReplicatedJobs, rJob.Name) {
+			targetReplicas += rJob.Replicas
+		}
+	}
+	if *successPolicy.MinSucceeded > targetReplicas {
+		allErrs = append(allErrs, field.Invalid(fieldPath, *successPolicy.MinSucceeded, fmt.Sprintf("must not exceed the number of replicas of the target replicated jobs (%d)", targetReplicas)))
+	}
+	return allErrs
+}
+
 // validateFailurePolicy performs validation for jobset failure policies and returns all errors detected.
 func validateFailurePolicy(failurePolicy *jobset.FailurePolicy, rJobNames sets.Set[string]) []error {
 	var allErrs []error
@@ -351,12 +551,39 @@ func validateFailurePolicy(failurePolicy *jobset.FailurePolicy, rJobNames sets.S
 			}
 		}
 
+		// Validate the rule action is valid. An unset action is rejected by the CRD schema.
+		if rule.Action != "" && !slices.Contains(validFailurePolicyActions, rule.Action) {
+			allErrs = append(allErrs, fmt.Errorf("invalid failure policy action '%s' in failure policy rule '%s'", rule.Action, rule.Name))
+		}
+
 		// Validate the rules on job failure reasons are valid
 		for _, failureReason := range rule.OnJobFailureReasons {
 			if !slices.Contains(validOnJobFailureReasons, failureReason) {
 				allErrs = append(allErrs, fmt.Errorf("invalid job failure reason '%s' in failure policy is not a recognized job failure reason", failureReason))
 			}
 		}
+
+		// Validate the rules on pod exit codes and pod conditions are valid
+		fieldPath := field.NewPath("spec", "failurePolicy", "rules").Index(index)
+		if rule.OnPodExitCodes != nil {
+			allErrs = append(allErrs, validateOnPodExitCodes(rule.OnPodExitCodes, fieldPath.Child("onPodExitCodes"))...)
+		}
+		for conditionIdx, pattern := range rule.OnPodConditions {
+			conditionPath := fieldPath.Child("onPodConditions").Index(conditionIdx)
+			if pattern.Type == "" {
+				allErrs = append(allErrs, field.Required(conditionPath.Child("type"), "pod condition type must be set"))
+			}
+			if !slices.Contains(validPodConditionStatuses, pattern.Status) {
+				allErrs = append(allErrs, field.NotSupported(conditionPath.Child("status"), pattern.Status, validPodConditionStatuses))
+			}
+		}
+	}
+
+	// Validate the replicated jobs with a max restarts override are valid
+	for _, rJobMaxRestarts := range failurePolicy.ReplicatedJobMaxRestarts {
+		if !rJobNames.Has(rJobMaxRestarts.Name) {
+			allErrs = append(allErrs, fmt.Errorf("invalid replicatedJob name '%s' in failure policy replicatedJobMaxRestarts does not appear in .spec.ReplicatedJobs", rJobMaxRestarts.Name))
+		}
 	}
 
 	// Checking that rule names are unique
@@ -370,6 +597,58 @@ func validateFailurePolicy(failurePolicy *jobset.FailurePolicy, rJobNames sets.S
 	return allErrs
 }
 
+// validPodConditionStatuses are the statuses a failure policy rule can match pod conditions on.
+var validPodConditionStatuses = []corev1.ConditionStatus{corev1.ConditionTrue, corev1.ConditionFalse, corev1.ConditionUnknown}
+
+// validateOnPodExitCodes validates the following:
+// 1. the operator is either In or NotIn.
+// 2. the values are set and unique.
+// 3. the values do not contain 0 when using the In operator, since successful containers are never matched.
+func validateOnPodExitCodes(requirement *batchv1.PodFailurePolicyOnExitCodesRequirement, fieldPath *field.Path) []error {
+	var allErrs []error
+	validOperators := []batchv1.PodFailurePolicyOnExitCodesOperator{batchv1.PodFailurePolicyOnExitCodesOpIn, batchv1.PodFailurePolicyOnExitCodesOpNotIn}
+	if !slices.Contains(validOperators, requirement.Operator) {
+		allErrs = append(allErrs, field.NotSupported(fieldPath.Child("operator"), requirement.Operator, validOperators))
+	}
+	if len(requirement.Values) == 0 {
+		allErrs = append(allErrs, field.Required(fieldPath.Child("values"), "at least one exit code must be set"))
+	}
+	seen := sets.New[int32]()
+	for i, value := range requirement.Values {
+		if seen.Has(value) {
+			allErrs = append(allErrs, field.Duplicate(fieldPath.Child("values").Index(i), value))
+		}
+		seen.Insert(value)
+		if requirement.Operator == batchv1.PodFailurePolicyOnExitCodesOpIn && value == 0 {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("values").Index(i), value, "must not be 0 for the In operator"))
+		}
+	}
+	return allErrs
+}
+
+// validateInPlaceRestart validates the following:
+// 1. the InPlaceRestart feature gate is enabled.
+// 2. the backoffLimit of every replicatedJob is set to MaxInt32, so pod failures do not fail the Job.
+// 3. the podReplacementPolicy of every replicatedJob is Failed, so replacement pods are only
+// created once the failed pods are fully terminated.
+func validateInPlaceRestart(js *jobset.JobSet) []error {
+	var allErrs []error
+	if !features.Enabled(features.InPlaceRestart) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "failurePolicy", "restartStrategy"), js.Spec.FailurePolicy.RestartStrategy, fmt.Sprintf("the %s feature gate must be enabled", features.InPlaceRestart)))
+		return allErrs
+	}
+	for rJobIdx, rJob := range js.Spec.ReplicatedJobs {
+		fieldPath := field.NewPath("spec", "replicatedJobs").Index(rJobIdx).Child("template", "spec")
+		if ptr.Deref(rJob.Template.Spec.BackoffLimit, 0) != math.MaxInt32 {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("backoffLimit"), rJob.Template.Spec.BackoffLimit, fmt.Sprintf("must be %d when using the %s restart strategy", math.MaxInt32, jobset.InPlaceRestart)))
+		}
+		if ptr.Deref(rJob.Template.Spec.PodReplacementPolicy, "") != batchv1.Failed {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("podReplacementPolicy"), rJob.Template.Spec.PodReplacementPolicy, fmt.Sprintf("must be %s when using the %s restart strategy", batchv1.Failed, jobset.InPlaceRestart)))
+		}
+	}
+	return allErrs
+}
+
 // validateCoordinator validates the following:
 // 1. coordinator replicatedJob is a valid replicatedJob in the JobSet spec.
 // 2. coordinator jobIndex is a valid index for the replicatedJob.
@@ -398,6 +677,205 @@ func validateCoordinator(js *jobset.JobSet) error {
 	return nil
 }
 
+// validateSubdomain validates that the given subdomain is a valid DNS name which can also be used
+// as service name.
+func validateSubdomain(fieldPath *field.Path, subdomain, tooLongErrMsg string) []error {
+	var allErrs []error
+	// This can return 1 or 2 errors, validating max length and format
+	for _, errMessage := range validation.IsDNS1123Subdomain(subdomain) {
+		allErrs = append(allErrs, field.Invalid(fieldPath, subdomain, errMessage))
+	}
+
+	// Since subdomain name is also used as service name, it must adhere to RFC 1035 as well.
+	for _, errMessage := range validation.IsDNS1035Label(subdomain) {
+		if strings.Contains(errMessage, dns1035MaxLengthExceededErrorMsg) {
+			errMessage = tooLongErrMsg
+		}
+
+		allErrs = append(allErrs, field.Invalid(fieldPath, subdomain, errMessage))
+	}
+	return allErrs
+}
+
+// validateReplicatedJobNetworks validates the network overrides of the replicated jobs. The
+// subdomain of each override must be a valid DNS name, unique among the replicated jobs and
+// different from the subdomain of the JobSet, since a headless service is created for each of them.
+func validateReplicatedJobNetworks(js *jobset.JobSet) []error {
+	var allErrs []error
+	subdomains := sets.New[string]()
+	if js.Spec.Network != nil && js.Spec.Network.Subdomain != "" {
+		subdomains.Insert(js.Spec.Network.Subdomain)
+	} else if js.Name != "" {
+		subdomains.Insert(js.Name)
+	}
+	for rJobIdx, rJob := range js.Spec.ReplicatedJobs {
+		if rJob.Network == nil {
+			continue
+		}
+		fieldPath := field.NewPath("spec", "replicatedJobs").Index(rJobIdx).Child("network")
+		if js.Spec.Network != nil && !ptr.Deref(js.Spec.Network.EnableDNSHostnames, true) {
+			allErrs = append(allErrs, field.Forbidden(fieldPath, "network can only be set when spec.network.enableDNSHostnames is true"))
+		}
+		subdomain := rJob.Network.Subdomain
+		if subdomain == "" {
+			allErrs = append(allErrs, field.Required(fieldPath.Child("subdomain"), "subdomain must be set"))
+			continue
+		}
+		allErrs = append(allErrs, validateSubdomain(fieldPath.Child("subdomain"), subdomain, replicatedJobSubdomainTooLongErrMsg)...)
+		if subdomains.Has(subdomain) {
+			allErrs = append(allErrs, field.Duplicate(fieldPath.Child("subdomain"), subdomain))
+		}
+		subdomains.Insert(subdomain)
+	}
+	return allErrs
+}
+
+// tensorFlowTaskTypes are the task types of TF_CONFIG. The replicated jobs using the TensorFlow
+// framework preset are used as the task type of the same name.
+var tensorFlowTaskTypes = sets.New("chief", "worker", "ps", "evaluator")
+
+// validateFrameworks validates the framework presets of the JobSet and its replicated jobs. Their
+// rendezvous environment variables are derived from the hostnames and completion indexes of the
+// pods, which requires DNS hostnames and the Indexed completion mode. The replicated jobs using
+// the TensorFlow framework preset must be named after a TF_CONFIG task type.
+func validateFrameworks(js *jobset.JobSet) []error {
+	var allErrs []error
+	dnsHostnamesDisabled := js.Spec.Network != nil && !ptr.Deref(js.Spec.Network.EnableDNSHostnames, true)
+	if js.Spec.Framework != nil && dnsHostnamesDisabled {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "framework"), "framework can only be set when spec.network.enableDNSHostnames is true"))
+	}
+	for rJobIdx, rJob := range js.Spec.ReplicatedJobs {
+		rJobPath := field.NewPath("spec", "replicatedJobs").Index(rJobIdx)
+		if rJob.Framework != nil && dnsHostnamesDisabled {
+			allErrs = append(allErrs, field.Forbidden(rJobPath.Child("framework"), "framework can only be set when spec.network.enableDNSHostnames is true"))
+		}
+		framework := rJob.Framework
+		if framework == nil {
+			framework = js.Spec.Framework
+		}
+		if framework == nil {
+			continue
+		}
+		if framework.Name == jobset.TensorFlow && !tensorFlowTaskTypes.Has(rJob.Name) {
+			allErrs = append(allErrs, field.NotSupported(rJobPath.Child("name"), rJob.Name, sets.List(tensorFlowTaskTypes)))
+		}
+		if completionMode := rJob.Template.Spec.CompletionMode; completionMode != nil && *completionMode != batchv1.IndexedCompletion {
+			allErrs = append(allErrs, field.Invalid(rJobPath.Child("template", "spec", "completionMode"), *completionMode, "completionMode must be Indexed when a framework is set"))
+		}
+	}
+	return allErrs
+}
+
+// validateNetworkGroup validates the network group of the JobSet, and that the JobSets sharing its
+// subdomain are consistent with it: they must all be in the same network group and publish the DNS
+// records of not ready pods alike, since they share a single headless service.
+func (j *jobSetWebhook) validateNetworkGroup(ctx context.Context, js *jobset.JobSet) []error {
+	if js.Spec.Network == nil {
+		return nil
+	}
+	network := js.Spec.Network
+	fieldPath := field.NewPath("spec", "network")
+
+	var allErrs []error
+	if network.Group != "" {
+		for _, errMessage := range validation.IsValidLabelValue(network.Group) {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("group"), network.Group, errMessage))
+		}
+		if network.Subdomain == "" {
+			allErrs = append(allErrs, field.Required(fieldPath.Child("subdomain"), "subdomain must be set when group is set"))
+		}
+		if !ptr.Deref(network.EnableDNSHostnames, true) {
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Child("group"), "group can only be set when enableDNSHostnames is true"))
+		}
+	}
+	if network.Subdomain == "" {
+		return allErrs
+	}
+
+	var jobSets jobset.JobSetList
+	if err := j.client.List(ctx, &jobSets, client.InNamespace(js.Namespace)); err != nil {
+		return append(allErrs, err)
+	}
+	for _, other := range jobSets.Items {
+		if other.Name == js.Name || other.Spec.Network == nil || controllers.GetSubdomain(&other) != network.Subdomain {
+			continue
+		}
+		otherGroup := other.Spec.Network.Group
+		if network.Group == "" && otherGroup == "" {
+			continue
+		}
+		switch {
+		case otherGroup == "":
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("subdomain"), network.Subdomain,
+				fmt.Sprintf("subdomain is used by JobSet %q which is not in network group %q", other.Name, network.Group)))
+		case otherGroup != network.Group:
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("subdomain"), network.Subdomain,
+				fmt.Sprintf("subdomain is used by JobSet %q of network group %q", other.Name, otherGroup)))
+		case ptr.Deref(other.Spec.Network.PublishNotReadyAddresses, true) != ptr.Deref(network.PublishNotReadyAddresses, true):
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("publishNotReadyAddresses"), ptr.Deref(network.PublishNotReadyAddresses, true),
+				fmt.Sprintf("publishNotReadyAddresses must match JobSet %q of network group %q", other.Name, otherGroup)))
+		case !apiequality.Semantic.DeepEqual(other.Spec.Network.Ports, network.Ports):
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("ports"), network.Ports,
+				fmt.Sprintf("ports must match JobSet %q of network group %q", other.Name, otherGroup)))
+		}
+	}
+	return allErrs
+}
+
+// validateNetworkPorts validates the names and numbers of the given ports exposed by a service.
+func validateNetworkPorts(fieldPath *field.Path, ports []jobset.NetworkPort) []error {
+	var allErrs []error
+	names := sets.New[string]()
+	for i, port := range ports {
+		portPath := fieldPath.Index(i)
+		for _, errMessage := range validation.IsValidPortName(port.Name) {
+			allErrs = append(allErrs, field.Invalid(portPath.Child("name"), port.Name, errMessage))
+		}
+		if names.Has(port.Name) {
+			allErrs = append(allErrs, field.Duplicate(portPath.Child("name"), port.Name))
+		}
+		names.Insert(port.Name)
+		for _, errMessage := range validation.IsValidPortNum(int(port.Port)) {
+			allErrs = append(allErrs, field.Invalid(portPath.Child("port"), port.Port, errMessage))
+		}
+		if port.TargetPort == nil {
+			continue
+		}
+		if port.TargetPort.Type == intstr.String {
+			for _, errMessage := range validation.IsValidPortName(port.TargetPort.StrVal) {
+				allErrs = append(allErrs, field.Invalid(portPath.Child("targetPort"), port.TargetPort.StrVal, errMessage))
+			}
+		} else {
+			for _, errMessage := range validation.IsValidPortNum(port.TargetPort.IntValue()) {
+				allErrs = append(allErrs, field.Invalid(portPath.Child("targetPort"), port.TargetPort.IntVal, errMessage))
+			}
+		}
+	}
+	return allErrs
+}
+
+// validateCoordinatorSvc validates the service of the coordinator pod, if enabled. The service
+// must expose at least one port, and its name must be a valid service name.
+func validateCoordinatorSvc(js *jobset.JobSet) []error {
+	if js.Spec.Coordinator.Service == nil {
+		return nil
+	}
+	fieldPath := field.NewPath("spec", "coordinator", "service")
+
+	var allErrs []error
+	ports := js.Spec.Coordinator.Service.Ports
+	allErrs = append(allErrs, validateNetworkPorts(fieldPath.Child("ports"), ports)...)
+	if len(ports) == 0 && (js.Spec.Network == nil || len(js.Spec.Network.Ports) == 0) {
+		allErrs = append(allErrs, field.Required(fieldPath.Child("ports"), "ports must be set when spec.network.ports is not set"))
+	}
+	if js.Name != "" {
+		for _, errMessage := range validation.IsDNS1035Label(controllers.CoordinatorSvcName(js)) {
+			allErrs = append(allErrs, field.Invalid(fieldPath, controllers.CoordinatorSvcName(js), errMessage))
+		}
+	}
+	return allErrs
+}
+
 // If spec will lead to invalid coordinator label value, return error
 // This usually happens when the JobSet name is too long
 func validateCoordinatorLabelValue(js *jobset.JobSet) error {
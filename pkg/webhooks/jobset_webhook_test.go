@@ -14,13 +14,19 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/component-base/featuregate"
 	"k8s.io/utils/ptr"
 
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
+	"sigs.k8s.io/jobset/pkg/features"
 )
 
 // TestPodTemplate is the default pod template spec used for testing.
@@ -36,6 +42,13 @@ var TestPodTemplate = corev1.PodTemplateSpec{
 	},
 }
 
+// newFakeClient returns a fake client with the JobSet API registered, holding the given objects.
+func newFakeClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(jobset.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
 type jobSetDefaultingTestCase struct {
 	name string
 	js   *jobset.JobSet
@@ -712,6 +725,95 @@ func TestJobSetDefaulting(t *testing.T) {
 		},
 	}
 
+	scaleTests := []jobSetDefaultingTestCase{
+		{
+			name: "scale replicas are defaulted to the replicas of the target replicated job",
+			js: &jobset.JobSet{
+				Spec: jobset.JobSetSpec{
+					SuccessPolicy: defaultSuccessPolicy,
+					StartupPolicy: defaultStartupPolicy,
+					Network:       defaultNetwork,
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:     "workers",
+							Replicas: 3,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Template:       TestPodTemplate,
+									CompletionMode: ptr.To(batchv1.IndexedCompletion),
+								},
+							},
+						},
+					},
+					Scale: &jobset.ReplicatedJobScale{ReplicatedJob: "workers"},
+				},
+			},
+			want: &jobset.JobSet{
+				Spec: jobset.JobSetSpec{
+					SuccessPolicy: defaultSuccessPolicy,
+					StartupPolicy: defaultStartupPolicy,
+					Network:       defaultNetwork,
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:     "workers",
+							Replicas: 3,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Template:       TestPodTemplate,
+									CompletionMode: ptr.To(batchv1.IndexedCompletion),
+								},
+							},
+						},
+					},
+					Scale: &jobset.ReplicatedJobScale{ReplicatedJob: "workers", Replicas: ptr.To[int32](3)},
+				},
+			},
+		},
+		{
+			name: "scale replicas are not overwritten",
+			js: &jobset.JobSet{
+				Spec: jobset.JobSetSpec{
+					SuccessPolicy: defaultSuccessPolicy,
+					StartupPolicy: defaultStartupPolicy,
+					Network:       defaultNetwork,
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:     "workers",
+							Replicas: 3,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Template:       TestPodTemplate,
+									CompletionMode: ptr.To(batchv1.IndexedCompletion),
+								},
+							},
+						},
+					},
+					Scale: &jobset.ReplicatedJobScale{ReplicatedJob: "workers", Replicas: ptr.To[int32](5)},
+				},
+			},
+			want: &jobset.JobSet{
+				Spec: jobset.JobSetSpec{
+					SuccessPolicy: defaultSuccessPolicy,
+					StartupPolicy: defaultStartupPolicy,
+					Network:       defaultNetwork,
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:     "workers",
+							Replicas: 3,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Template:       TestPodTemplate,
+									CompletionMode: ptr.To(batchv1.IndexedCompletion),
+								},
+							},
+						},
+					},
+					Scale: &jobset.ReplicatedJobScale{ReplicatedJob: "workers", Replicas: ptr.To[int32](5)},
+				},
+			},
+		},
+	}
+
 	testGroups := [][]jobSetDefaultingTestCase{
 		jobCompletionTests,
 		enablingDNSHostnameTests,
@@ -722,6 +824,7 @@ func TestJobSetDefaulting(t *testing.T) {
 		startupPolicyTests,
 		managedByTests,
 		failurePolicyRuleNameTests,
+		scaleTests,
 	}
 	var testCases []jobSetDefaultingTestCase
 	for _, testGroup := range testGroups {
@@ -747,9 +850,10 @@ func TestJobSetDefaulting(t *testing.T) {
 }
 
 type validationTestCase struct {
-	name string
-	js   *jobset.JobSet
-	want error
+	name         string
+	js           *jobset.JobSet
+	featureGates map[featuregate.Feature]bool
+	want         error
 }
 
 // TestValidateCreate tests the ValidateCreate method of the jobset webhook.
@@ -889,6 +993,169 @@ func TestValidateCreate(t *testing.T) {
 				fmt.Errorf("invalid replicatedJob name 'does not exist' does not appear in .spec.ReplicatedJobs"),
 			),
 		},
+		{
+			name: "success policy with AtLeast operator is valid",
+			js: &jobset.JobSet{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "JobSet",
+					APIVersion: "jobset.x-k8s.io/v1alpha2",
+				},
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:      "trainer",
+							GroupName: "default",
+							Replicas:  3,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Template: validPodTemplateSpec,
+								},
+							},
+						},
+						{
+							Name:      "evaluator",
+							GroupName: "default",
+							Replicas:  1,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Template: validPodTemplateSpec,
+								},
+							},
+						},
+					},
+					SuccessPolicy: &jobset.SuccessPolicy{
+						Operator:             jobset.OperatorAtLeast,
+						TargetReplicatedJobs: []string{"trainer"},
+						MinSucceeded:         ptr.To[int32](2),
+					},
+				},
+			},
+			want: errors.Join(),
+		},
+		{
+			name: "success policy with AtLeast operator is missing minSucceeded",
+			js: &jobset.JobSet{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "JobSet",
+					APIVersion: "jobset.x-k8s.io/v1alpha2",
+				},
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:      "trainer",
+							GroupName: "default",
+							Replicas:  3,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Template: validPodTemplateSpec,
+								},
+							},
+						},
+						{
+							Name:      "evaluator",
+							GroupName: "default",
+							Replicas:  1,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Template: validPodTemplateSpec,
+								},
+							},
+						},
+					},
+					SuccessPolicy: &jobset.SuccessPolicy{
+						Operator: jobset.OperatorAtLeast,
+					},
+				},
+			},
+			want: errors.Join(
+				field.Required(field.NewPath("spec", "successPolicy", "minSucceeded"), "minSucceeded must be set with the AtLeast operator"),
+			),
+		},
+		{
+			name: "success policy minSucceeded exceeds the target replicas",
+			js: &jobset.JobSet{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "JobSet",
+					APIVersion: "jobset.x-k8s.io/v1alpha2",
+				},
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:      "trainer",
+							GroupName: "default",
+							Replicas:  3,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Template: validPodTemplateSpec,
+								},
+							},
+						},
+						{
+							Name:      "evaluator",
+							GroupName: "default",
+							Replicas:  1,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Template: validPodTemplateSpec,
+								},
+							},
+						},
+					},
+					SuccessPolicy: &jobset.SuccessPolicy{
+						Operator:             jobset.OperatorAtLeast,
+						TargetReplicatedJobs: []string{"trainer"},
+						MinSucceeded:         ptr.To[int32](4),
+					},
+				},
+			},
+			want: errors.Join(
+				field.Invalid(field.NewPath("spec", "successPolicy", "minSucceeded"), int32(4), "must not exceed the number of replicas of the target replicated jobs (3)"),
+			),
+		},
+		{
+			name: "success policy minSucceeded set without AtLeast operator",
+			js: &jobset.JobSet{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "JobSet",
+					APIVersion: "jobset.x-k8s.io/v1alpha2",
+				},
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:      "trainer",
+							GroupName: "default",
+							Replicas:  3,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Template: validPodTemplateSpec,
+								},
+							},
+						},
+						{
+							Name:      "evaluator",
+							GroupName: "default",
+							Replicas:  1,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Template: validPodTemplateSpec,
+								},
+							},
+						},
+					},
+					SuccessPolicy: &jobset.SuccessPolicy{
+						Operator:     jobset.OperatorAll,
+						MinSucceeded: ptr.To[int32](1),
+					},
+				},
+			},
+			want: errors.Join(
+				field.Forbidden(field.NewPath("spec", "successPolicy", "minSucceeded"), "minSucceeded can only be set with the AtLeast operator"),
+			),
+		},
 		{
 			name: "network has invalid dns name",
 			js: &jobset.JobSet{
@@ -1224,7 +1491,7 @@ func TestValidateCreate(t *testing.T) {
 			),
 		},
 		{
-			name: "jobset failure policy has an invalid replicated job",
+			name: "jobset failure policy has valid pod exit codes and pod conditions",
 			js: &jobset.JobSet{
 				ObjectMeta: validObjectMeta,
 				Spec: jobset.JobSetSpec{
@@ -1232,8 +1499,15 @@ func TestValidateCreate(t *testing.T) {
 						MaxRestarts: 1,
 						Rules: []jobset.FailurePolicyRule{
 							{
-								Action:               jobset.FailJobSet,
-								TargetReplicatedJobs: []string{"fakeReplicatedJob"},
+								Name:   "rule",
+								Action: jobset.RestartJobSetAndIgnoreMaxRestarts,
+								OnPodExitCodes: &batchv1.PodFailurePolicyOnExitCodesRequirement{
+									Operator: batchv1.PodFailurePolicyOnExitCodesOpIn,
+									Values:   []int32{42, 43},
+								},
+								OnPodConditions: []batchv1.PodFailurePolicyOnPodConditionsPattern{
+									{Type: corev1.DisruptionTarget, Status: corev1.ConditionTrue},
+								},
 							},
 						},
 					},
@@ -1254,15 +1528,26 @@ func TestValidateCreate(t *testing.T) {
 					SuccessPolicy: &jobset.SuccessPolicy{},
 				},
 			},
-			want: errors.Join(
-				fmt.Errorf("invalid replicatedJob name '%s' in failure policy does not appear in .spec.ReplicatedJobs", "fakeReplicatedJob"),
-			),
+			want: errors.Join(),
 		},
 		{
-			name: "jobset failure policy rule name is 0 characters long a.k.a unset",
+			name: "jobset failure policy has invalid pod exit codes",
 			js: &jobset.JobSet{
 				ObjectMeta: validObjectMeta,
 				Spec: jobset.JobSetSpec{
+					FailurePolicy: &jobset.FailurePolicy{
+						MaxRestarts: 1,
+						Rules: []jobset.FailurePolicyRule{
+							{
+								Name:   "rule",
+								Action: jobset.RestartJobSetAndIgnoreMaxRestarts,
+								OnPodExitCodes: &batchv1.PodFailurePolicyOnExitCodesRequirement{
+									Operator: batchv1.PodFailurePolicyOnExitCodesOpIn,
+									Values:   []int32{0, 42, 42},
+								},
+							},
+						},
+					},
 					ReplicatedJobs: []jobset.ReplicatedJob{
 						{
 							Name:      "rj",
@@ -1277,21 +1562,31 @@ func TestValidateCreate(t *testing.T) {
 							},
 						},
 					},
-					FailurePolicy: &jobset.FailurePolicy{
-						Rules: make([]jobset.FailurePolicyRule, 1),
-					},
 					SuccessPolicy: &jobset.SuccessPolicy{},
 				},
 			},
 			want: errors.Join(
-				fmt.Errorf("invalid failure policy rule name of length %v, the rule name must be at least %v characters long and at most %v characters long", 0, minRuleNameLength, maxRuleNameLength),
+				field.Invalid(field.NewPath("spec", "failurePolicy", "rules").Index(0).Child("onPodExitCodes", "values").Index(0), int32(0), "must not be 0 for the In operator"),
+				field.Duplicate(field.NewPath("spec", "failurePolicy", "rules").Index(0).Child("onPodExitCodes", "values").Index(2), int32(42)),
 			),
 		},
 		{
-			name: "jobset failure policy rule name is greater than 128 characters long",
+			name: "jobset failure policy has invalid pod conditions",
 			js: &jobset.JobSet{
 				ObjectMeta: validObjectMeta,
 				Spec: jobset.JobSetSpec{
+					FailurePolicy: &jobset.FailurePolicy{
+						MaxRestarts: 1,
+						Rules: []jobset.FailurePolicyRule{
+							{
+								Name:   "rule",
+								Action: jobset.RestartJobSetAndIgnoreMaxRestarts,
+								OnPodConditions: []batchv1.PodFailurePolicyOnPodConditionsPattern{
+									{Type: corev1.DisruptionTarget, Status: "Maybe"},
+								},
+							},
+						},
+					},
 					ReplicatedJobs: []jobset.ReplicatedJob{
 						{
 							Name:      "rj",
@@ -1306,28 +1601,248 @@ func TestValidateCreate(t *testing.T) {
 							},
 						},
 					},
-					FailurePolicy: &jobset.FailurePolicy{
-						Rules: []jobset.FailurePolicyRule{
-							{Name: strings.Repeat("a", 129)},
-						},
-					},
 					SuccessPolicy: &jobset.SuccessPolicy{},
 				},
 			},
 			want: errors.Join(
-				fmt.Errorf("invalid failure policy rule name of length %v, the rule name must be at least %v characters long and at most %v characters long", 129, minRuleNameLength, maxRuleNameLength),
+				field.NotSupported(field.NewPath("spec", "failurePolicy", "rules").Index(0).Child("onPodConditions").Index(0).Child("status"), corev1.ConditionStatus("Maybe"), []corev1.ConditionStatus{corev1.ConditionTrue, corev1.ConditionFalse, corev1.ConditionUnknown}),
 			),
 		},
 		{
-			name: "there are two failure policy rules with the same name",
+			name: "jobset failure policy has a SuspendJobSet action",
 			js: &jobset.JobSet{
 				ObjectMeta: validObjectMeta,
 				Spec: jobset.JobSetSpec{
-					ReplicatedJobs: []jobset.ReplicatedJob{
-						{
-							Name:      "rj",
-							GroupName: "default",
-							Replicas:  1,
+					FailurePolicy: &jobset.FailurePolicy{
+						MaxRestarts: 1,
+						Rules: []jobset.FailurePolicyRule{
+							{
+								Name:   "rule",
+								Action: jobset.SuspendJobSet,
+							},
+						},
+					},
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:      "rj",
+							GroupName: "default",
+							Replicas:  1,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									CompletionMode: ptr.To(batchv1.IndexedCompletion),
+									Completions:    ptr.To(int32(1)),
+									Parallelism:    ptr.To(int32(1)),
+								},
+							},
+						},
+					},
+					SuccessPolicy: &jobset.SuccessPolicy{},
+				},
+			},
+			want: errors.Join(),
+		},
+		{
+			name: "jobset failure policy has an invalid action",
+			js: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					FailurePolicy: &jobset.FailurePolicy{
+						MaxRestarts: 1,
+						Rules: []jobset.FailurePolicyRule{
+							{
+								Name:   "rule",
+								Action: "fakeAction",
+							},
+						},
+					},
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:      "rj",
+							GroupName: "default",
+							Replicas:  1,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									CompletionMode: ptr.To(batchv1.IndexedCompletion),
+									Completions:    ptr.To(int32(1)),
+									Parallelism:    ptr.To(int32(1)),
+								},
+							},
+						},
+					},
+					SuccessPolicy: &jobset.SuccessPolicy{},
+				},
+			},
+			want: errors.Join(
+				fmt.Errorf("invalid failure policy action '%s' in failure policy rule '%s'", "fakeAction", "rule"),
+			),
+		},
+		{
+			name: "jobset failure policy has a valid replicatedJob max restarts",
+			js: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					FailurePolicy: &jobset.FailurePolicy{
+						MaxRestarts: 1,
+						ReplicatedJobMaxRestarts: []jobset.ReplicatedJobMaxRestarts{
+							{Name: "rj", MaxRestarts: 3},
+						},
+					},
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:      "rj",
+							GroupName: "default",
+							Replicas:  1,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									CompletionMode: ptr.To(batchv1.IndexedCompletion),
+									Completions:    ptr.To(int32(1)),
+									Parallelism:    ptr.To(int32(1)),
+								},
+							},
+						},
+					},
+					SuccessPolicy: &jobset.SuccessPolicy{},
+				},
+			},
+			want: errors.Join(),
+		},
+		{
+			name: "jobset failure policy has a replicatedJob max restarts for an invalid replicated job",
+			js: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					FailurePolicy: &jobset.FailurePolicy{
+						MaxRestarts: 1,
+						ReplicatedJobMaxRestarts: []jobset.ReplicatedJobMaxRestarts{
+							{Name: "fakeReplicatedJob", MaxRestarts: 3},
+						},
+					},
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:      "rj",
+							GroupName: "default",
+							Replicas:  1,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									CompletionMode: ptr.To(batchv1.IndexedCompletion),
+									Completions:    ptr.To(int32(1)),
+									Parallelism:    ptr.To(int32(1)),
+								},
+							},
+						},
+					},
+					SuccessPolicy: &jobset.SuccessPolicy{},
+				},
+			},
+			want: errors.Join(
+				fmt.Errorf("invalid replicatedJob name '%s' in failure policy replicatedJobMaxRestarts does not appear in .spec.ReplicatedJobs", "fakeReplicatedJob"),
+			),
+		},
+		{
+			name: "jobset failure policy has an invalid replicated job",
+			js: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					FailurePolicy: &jobset.FailurePolicy{
+						MaxRestarts: 1,
+						Rules: []jobset.FailurePolicyRule{
+							{
+								Action:               jobset.FailJobSet,
+								TargetReplicatedJobs: []string{"fakeReplicatedJob"},
+							},
+						},
+					},
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:      "rj",
+							GroupName: "default",
+							Replicas:  1,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									CompletionMode: ptr.To(batchv1.IndexedCompletion),
+									Completions:    ptr.To(int32(1)),
+									Parallelism:    ptr.To(int32(1)),
+								},
+							},
+						},
+					},
+					SuccessPolicy: &jobset.SuccessPolicy{},
+				},
+			},
+			want: errors.Join(
+				fmt.Errorf("invalid replicatedJob name '%s' in failure policy does not appear in .spec.ReplicatedJobs", "fakeReplicatedJob"),
+			),
+		},
+		{
+			name: "jobset failure policy rule name is 0 characters long a.k.a unset",
+			js: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:      "rj",
+							GroupName: "default",
+							Replicas:  1,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									CompletionMode: ptr.To(batchv1.IndexedCompletion),
+									Completions:    ptr.To(int32(1)),
+									Parallelism:    ptr.To(int32(1)),
+								},
+							},
+						},
+					},
+					FailurePolicy: &jobset.FailurePolicy{
+						Rules: make([]jobset.FailurePolicyRule, 1),
+					},
+					SuccessPolicy: &jobset.SuccessPolicy{},
+				},
+			},
+			want: errors.Join(
+				fmt.Errorf("invalid failure policy rule name of length %v, the rule name must be at least %v characters long and at most %v characters long", 0, minRuleNameLength, maxRuleNameLength),
+			),
+		},
+		{
+			name: "jobset failure policy rule name is greater than 128 characters long",
+			js: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:      "rj",
+							GroupName: "default",
+							Replicas:  1,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									CompletionMode: ptr.To(batchv1.IndexedCompletion),
+									Completions:    ptr.To(int32(1)),
+									Parallelism:    ptr.To(int32(1)),
+								},
+							},
+						},
+					},
+					FailurePolicy: &jobset.FailurePolicy{
+						Rules: []jobset.FailurePolicyRule{
+							{Name: strings.Repeat("a", 129)},
+						},
+					},
+					SuccessPolicy: &jobset.SuccessPolicy{},
+				},
+			},
+			want: errors.Join(
+				fmt.Errorf("invalid failure policy rule name of length %v, the rule name must be at least %v characters long and at most %v characters long", 129, minRuleNameLength, maxRuleNameLength),
+			),
+		},
+		{
+			name: "there are two failure policy rules with the same name",
+			js: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:      "rj",
+							GroupName: "default",
+							Replicas:  1,
 							Template: batchv1.JobTemplateSpec{
 								Spec: batchv1.JobSpec{
 									CompletionMode: ptr.To(batchv1.IndexedCompletion),
@@ -1679,6 +2194,107 @@ func TestValidateCreate(t *testing.T) {
 			},
 			want: fmt.Errorf("spec will lead to invalid label value"),
 		},
+		{
+			name: "in-place restart with valid replicated jobs",
+			featureGates: map[featuregate.Feature]bool{
+				features.InPlaceRestart: true,
+			},
+			js: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					FailurePolicy: &jobset.FailurePolicy{
+						MaxRestarts:     1,
+						RestartStrategy: jobset.InPlaceRestart,
+					},
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:      "rj",
+							GroupName: "default",
+							Replicas:  1,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									CompletionMode:       ptr.To(batchv1.IndexedCompletion),
+									Completions:          ptr.To(int32(1)),
+									Parallelism:          ptr.To(int32(1)),
+									BackoffLimit:         ptr.To(int32(math.MaxInt32)),
+									PodReplacementPolicy: ptr.To(batchv1.Failed),
+								},
+							},
+						},
+					},
+					SuccessPolicy: &jobset.SuccessPolicy{},
+				},
+			},
+			want: errors.Join(),
+		},
+		{
+			name: "in-place restart with feature gate disabled",
+			js: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					FailurePolicy: &jobset.FailurePolicy{
+						MaxRestarts:     1,
+						RestartStrategy: jobset.InPlaceRestart,
+					},
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:      "rj",
+							GroupName: "default",
+							Replicas:  1,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									CompletionMode:       ptr.To(batchv1.IndexedCompletion),
+									Completions:          ptr.To(int32(1)),
+									Parallelism:          ptr.To(int32(1)),
+									BackoffLimit:         ptr.To(int32(math.MaxInt32)),
+									PodReplacementPolicy: ptr.To(batchv1.Failed),
+								},
+							},
+						},
+					},
+					SuccessPolicy: &jobset.SuccessPolicy{},
+				},
+			},
+			want: errors.Join(
+				field.Invalid(field.NewPath("spec", "failurePolicy", "restartStrategy"), jobset.InPlaceRestart, "the InPlaceRestart feature gate must be enabled"),
+			),
+		},
+		{
+			name: "in-place restart with invalid backoff limit and pod replacement policy",
+			featureGates: map[featuregate.Feature]bool{
+				features.InPlaceRestart: true,
+			},
+			js: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					FailurePolicy: &jobset.FailurePolicy{
+						MaxRestarts:     1,
+						RestartStrategy: jobset.InPlaceRestart,
+					},
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:      "rj",
+							GroupName: "default",
+							Replicas:  1,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									CompletionMode:       ptr.To(batchv1.IndexedCompletion),
+									Completions:          ptr.To(int32(1)),
+									Parallelism:          ptr.To(int32(1)),
+									BackoffLimit:         ptr.To(int32(6)),
+									PodReplacementPolicy: ptr.To(batchv1.TerminatingOrFailed),
+								},
+							},
+						},
+					},
+					SuccessPolicy: &jobset.SuccessPolicy{},
+				},
+			},
+			want: errors.Join(
+				field.Invalid(field.NewPath("spec", "replicatedJobs").Index(0).Child("template", "spec", "backoffLimit"), ptr.To(int32(6)), "must be 2147483647 when using the InPlaceRestart restart strategy"),
+				field.Invalid(field.NewPath("spec", "replicatedJobs").Index(0).Child("template", "spec", "podReplacementPolicy"), ptr.To(batchv1.TerminatingOrFailed), "must be Failed when using the InPlaceRestart restart strategy"),
+			),
+		},
 	}
 
 	dependsOnTests := []validationTestCase{
@@ -1843,7 +2459,7 @@ func TestValidateCreate(t *testing.T) {
 			want: errors.Join(),
 		},
 		{
-			name: "DependsOn is invalid since job-2 depends on job-3",
+			name: "DependsOn is valid since job-2 depends on job-3 which is defined later",
 			js: &jobset.JobSet{
 				ObjectMeta: validObjectMeta,
 				Spec: jobset.JobSetSpec{
@@ -1894,10 +2510,10 @@ func TestValidateCreate(t *testing.T) {
 					},
 				},
 			},
-			want: errors.Join(fmt.Errorf("replicatedJob: job-2 cannot depend on replicatedJob: job-3")),
+			want: errors.Join(),
 		},
 		{
-			name: "DependsOn is invalid since job-2 depends on job-3 and job-1",
+			name: "DependsOn is valid since job-2 depends on job-3 and job-1",
 			js: &jobset.JobSet{
 				ObjectMeta: validObjectMeta,
 				Spec: jobset.JobSetSpec{
@@ -1952,7 +2568,7 @@ func TestValidateCreate(t *testing.T) {
 					},
 				},
 			},
-			want: errors.Join(fmt.Errorf("replicatedJob: job-2 cannot depend on replicatedJob: job-3")),
+			want: errors.Join(),
 		},
 		{
 			name: "job-2 depends on invalid ReplicatedJob",
@@ -2003,7 +2619,7 @@ func TestValidateCreate(t *testing.T) {
 			},
 		},
 		{
-			name: "dependsOn cannot be set for first replicated job",
+			name: "first replicated job depends on invalid ReplicatedJob",
 			js: &jobset.JobSet{
 				ObjectMeta: validObjectMeta,
 				Spec: jobset.JobSetSpec{
@@ -2028,7 +2644,412 @@ func TestValidateCreate(t *testing.T) {
 					},
 				},
 			},
-			want: errors.Join(fmt.Errorf("DependsOn can't be set for the first ReplicatedJob")),
+			want: errors.Join(fmt.Errorf("replicatedJob: job-1 cannot depend on replicatedJob: invalid")),
+		},
+		{
+			name: "DependsOn is invalid since job-1, job-2 and job-3 form a cycle",
+			js: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					SuccessPolicy: &jobset.SuccessPolicy{},
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name: "job-1",
+							DependsOn: []jobset.DependsOn{
+								{
+									Name:   "job-3",
+									Status: "Complete",
+								},
+							},
+							GroupName: "default",
+							Replicas:  1,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Template: validPodTemplateSpec,
+								},
+							},
+						},
+						{
+							Name: "job-2",
+							DependsOn: []jobset.DependsOn{
+								{
+									Name:   "job-1",
+									Status: "Ready",
+								},
+							},
+							GroupName: "default",
+							Replicas:  1,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Template: validPodTemplateSpec,
+								},
+							},
+						},
+						{
+							Name: "job-3",
+							DependsOn: []jobset.DependsOn{
+								{
+									Name:   "job-2",
+									Status: "Complete",
+								},
+							},
+							GroupName: "default",
+							Replicas:  1,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Template: validPodTemplateSpec,
+								},
+							},
+						},
+					},
+				},
+			},
+			want: errors.Join(
+				field.Invalid(field.NewPath("spec", "replicatedJobs").Index(1).Child("dependsOn").Index(0).Child("name"), "job-1", "dependency cycle: job-1 -> job-3 -> job-2 -> job-1"),
+			),
+		},
+		{
+			name: "DependsOn is invalid since job-2 depends on itself",
+			js: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					SuccessPolicy: &jobset.SuccessPolicy{},
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:      "job-1",
+							GroupName: "default",
+							Replicas:  1,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Template: validPodTemplateSpec,
+								},
+							},
+						},
+						{
+							Name: "job-2",
+							DependsOn: []jobset.DependsOn{
+								{
+									Name:   "job-1",
+									Status: "Complete",
+								},
+								{
+									Name:   "job-2",
+									Status: "Ready",
+								},
+							},
+							GroupName: "default",
+							Replicas:  1,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Template: validPodTemplateSpec,
+								},
+							},
+						},
+					},
+				},
+			},
+			want: errors.Join(
+				field.Invalid(field.NewPath("spec", "replicatedJobs").Index(1).Child("dependsOn").Index(1).Child("name"), "job-2", "dependency cycle: job-2 -> job-2"),
+			),
+		},
+	}
+
+	scaleTests := []validationTestCase{
+		{
+			name: "scale targets an existing replicated job",
+			js: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					SuccessPolicy: &jobset.SuccessPolicy{},
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:      "workers",
+							GroupName: "default",
+							Replicas:  2,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Template: validPodTemplateSpec,
+								},
+							},
+						},
+					},
+					Scale: &jobset.ReplicatedJobScale{ReplicatedJob: "workers", Replicas: ptr.To[int32](2)},
+				},
+			},
+			want: errors.Join(),
+		},
+		{
+			name: "scale targets a replicated job which does not exist",
+			js: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					SuccessPolicy: &jobset.SuccessPolicy{},
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:      "workers",
+							GroupName: "default",
+							Replicas:  2,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Template: validPodTemplateSpec,
+								},
+							},
+						},
+					},
+					Scale: &jobset.ReplicatedJobScale{ReplicatedJob: "missing", Replicas: ptr.To[int32](2)},
+				},
+			},
+			want: errors.Join(
+				field.Invalid(field.NewPath("spec", "scale", "replicatedJob"), "missing", "must be the name of a replicated job in .spec.replicatedJobs"),
+			),
+		},
+		{
+			name: "scale targets a replicated job which is not the last one",
+			js: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					SuccessPolicy: &jobset.SuccessPolicy{},
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:      "workers",
+							GroupName: "default",
+							Replicas:  2,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Template: validPodTemplateSpec,
+								},
+							},
+						},
+						{
+							Name:      "driver",
+							GroupName: "default",
+							Replicas:  1,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Template: validPodTemplateSpec,
+								},
+							},
+						},
+					},
+					Scale: &jobset.ReplicatedJobScale{ReplicatedJob: "workers", Replicas: ptr.To[int32](2)},
+				},
+			},
+			want: errors.Join(
+				field.Invalid(field.NewPath("spec", "scale", "replicatedJob"), "workers", "must be the name of the last replicated job in .spec.replicatedJobs"),
+			),
+		},
+		{
+			name: "scale replicas do not match the replicas of the target replicated job",
+			js: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					SuccessPolicy: &jobset.SuccessPolicy{},
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:      "workers",
+							GroupName: "default",
+							Replicas:  2,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Template: validPodTemplateSpec,
+								},
+							},
+						},
+					},
+					Scale: &jobset.ReplicatedJobScale{ReplicatedJob: "workers", Replicas: ptr.To[int32](4)},
+				},
+			},
+			want: errors.Join(
+				field.Invalid(field.NewPath("spec", "scale", "replicas"), 4, "must match the replicas of replicated job 'workers' (2)"),
+			),
+		},
+	}
+
+	makeNetworkJobSet := func(network *jobset.Network, rjobNetworks ...*jobset.ReplicatedJobNetwork) *jobset.JobSet {
+		js := &jobset.JobSet{
+			ObjectMeta: validObjectMeta,
+			Spec: jobset.JobSetSpec{
+				SuccessPolicy: &jobset.SuccessPolicy{},
+				Network:       network,
+			},
+		}
+		for i, rjobNetwork := range rjobNetworks {
+			js.Spec.ReplicatedJobs = append(js.Spec.ReplicatedJobs, jobset.ReplicatedJob{
+				Name:      fmt.Sprintf("rjob-%d", i),
+				GroupName: "default",
+				Replicas:  1,
+				Template: batchv1.JobTemplateSpec{
+					Spec: batchv1.JobSpec{
+						Template: validPodTemplateSpec,
+					},
+				},
+				Network: rjobNetwork,
+			})
+		}
+		return js
+	}
+	networkTests := []validationTestCase{
+		{
+			name: "replicated jobs with distinct network subdomains",
+			js: makeNetworkJobSet(&jobset.Network{EnableDNSHostnames: ptr.To(true)},
+				nil,
+				&jobset.ReplicatedJobNetwork{Subdomain: "ps", PublishNotReadyAddresses: ptr.To(false)},
+				&jobset.ReplicatedJobNetwork{Subdomain: "workers"}),
+		},
+		{
+			name: "replicated job network requires dns hostnames",
+			js: makeNetworkJobSet(&jobset.Network{EnableDNSHostnames: ptr.To(false)},
+				&jobset.ReplicatedJobNetwork{Subdomain: "ps"}),
+			want: field.Forbidden(field.NewPath("spec", "replicatedJobs").Index(0).Child("network"), "network can only be set when spec.network.enableDNSHostnames is true"),
+		},
+		{
+			name: "replicated job network without subdomain",
+			js: makeNetworkJobSet(&jobset.Network{EnableDNSHostnames: ptr.To(true)},
+				&jobset.ReplicatedJobNetwork{PublishNotReadyAddresses: ptr.To(false)}),
+			want: field.Required(field.NewPath("spec", "replicatedJobs").Index(0).Child("network", "subdomain"), "subdomain must be set"),
+		},
+		{
+			name: "replicated job network subdomain is too long",
+			js: makeNetworkJobSet(&jobset.Network{EnableDNSHostnames: ptr.To(true)},
+				&jobset.ReplicatedJobNetwork{Subdomain: strings.Repeat("a", 64)}),
+			want: errors.New(replicatedJobSubdomainTooLongErrMsg),
+		},
+		{
+			name: "replicated job network subdomain is used by another replicated job",
+			js: makeNetworkJobSet(&jobset.Network{EnableDNSHostnames: ptr.To(true)},
+				&jobset.ReplicatedJobNetwork{Subdomain: "workers"},
+				&jobset.ReplicatedJobNetwork{Subdomain: "workers"}),
+			want: field.Duplicate(field.NewPath("spec", "replicatedJobs").Index(1).Child("network", "subdomain"), "workers"),
+		},
+		{
+			name: "replicated job network subdomain is the jobset subdomain",
+			js: makeNetworkJobSet(&jobset.Network{EnableDNSHostnames: ptr.To(true), Subdomain: "shared"},
+				&jobset.ReplicatedJobNetwork{Subdomain: "shared"}),
+			want: field.Duplicate(field.NewPath("spec", "replicatedJobs").Index(0).Child("network", "subdomain"), "shared"),
+		},
+		{
+			name: "replicated job network subdomain is the default jobset subdomain",
+			js: makeNetworkJobSet(&jobset.Network{EnableDNSHostnames: ptr.To(true)},
+				&jobset.ReplicatedJobNetwork{Subdomain: validObjectMeta.Name}),
+			want: field.Duplicate(field.NewPath("spec", "replicatedJobs").Index(0).Child("network", "subdomain"), validObjectMeta.Name),
+		},
+		{
+			name: "valid network ports",
+			js: makeNetworkJobSet(&jobset.Network{
+				EnableDNSHostnames: ptr.To(true),
+				Ports: []jobset.NetworkPort{
+					{Name: "grpc", Port: 8470},
+					{Name: "metrics", Port: 80, TargetPort: ptr.To(intstr.FromString("http-metrics"))},
+				},
+			}, nil),
+		},
+		{
+			name: "network port with an invalid name",
+			js: makeNetworkJobSet(&jobset.Network{
+				EnableDNSHostnames: ptr.To(true),
+				Ports:              []jobset.NetworkPort{{Name: "grpc_port", Port: 8470}},
+			}, nil),
+			want: field.Invalid(field.NewPath("spec", "network", "ports").Index(0).Child("name"), "grpc_port", validation.IsValidPortName("grpc_port")[0]),
+		},
+		{
+			name: "network ports with duplicate names",
+			js: makeNetworkJobSet(&jobset.Network{
+				EnableDNSHostnames: ptr.To(true),
+				Ports:              []jobset.NetworkPort{{Name: "grpc", Port: 8470}, {Name: "grpc", Port: 8471}},
+			}, nil),
+			want: field.Duplicate(field.NewPath("spec", "network", "ports").Index(1).Child("name"), "grpc"),
+		},
+		{
+			name: "network port with an invalid target port",
+			js: makeNetworkJobSet(&jobset.Network{
+				EnableDNSHostnames: ptr.To(true),
+				Ports:              []jobset.NetworkPort{{Name: "grpc", Port: 8470, TargetPort: ptr.To(intstr.FromInt32(70000))}},
+			}, nil),
+			want: field.Invalid(field.NewPath("spec", "network", "ports").Index(0).Child("targetPort"), 70000, validation.IsValidPortNum(70000)[0]),
+		},
+		{
+			name: "coordinator service with the network ports",
+			js: func() *jobset.JobSet {
+				js := makeNetworkJobSet(&jobset.Network{
+					EnableDNSHostnames: ptr.To(true),
+					Ports:              []jobset.NetworkPort{{Name: "dashboard", Port: 8265}},
+				}, nil)
+				js.Spec.ReplicatedJobs[0].Template.Spec.CompletionMode = ptr.To(batchv1.IndexedCompletion)
+				js.Spec.ReplicatedJobs[0].Template.Spec.Completions = ptr.To[int32](1)
+				js.Spec.Coordinator = &jobset.Coordinator{ReplicatedJob: "rjob-0", Service: &jobset.CoordinatorService{}}
+				return js
+			}(),
+		},
+		{
+			name: "coordinator service without ports",
+			js: func() *jobset.JobSet {
+				js := makeNetworkJobSet(&jobset.Network{EnableDNSHostnames: ptr.To(true)}, nil)
+				js.Spec.ReplicatedJobs[0].Template.Spec.CompletionMode = ptr.To(batchv1.IndexedCompletion)
+				js.Spec.ReplicatedJobs[0].Template.Spec.Completions = ptr.To[int32](1)
+				js.Spec.Coordinator = &jobset.Coordinator{ReplicatedJob: "rjob-0", Service: &jobset.CoordinatorService{}}
+				return js
+			}(),
+			want: field.Required(field.NewPath("spec", "coordinator", "service", "ports"), "ports must be set when spec.network.ports is not set"),
+		},
+	}
+
+	frameworkTests := []validationTestCase{
+		{
+			name: "jobset framework",
+			js: func() *jobset.JobSet {
+				js := makeNetworkJobSet(&jobset.Network{EnableDNSHostnames: ptr.To(true)}, nil)
+				js.Spec.ReplicatedJobs[0].Template.Spec.CompletionMode = ptr.To(batchv1.IndexedCompletion)
+				js.Spec.Framework = &jobset.Framework{Name: jobset.PyTorch}
+				return js
+			}(),
+		},
+		{
+			name: "jobset framework requires dns hostnames",
+			js: func() *jobset.JobSet {
+				js := makeNetworkJobSet(&jobset.Network{EnableDNSHostnames: ptr.To(false)}, nil)
+				js.Spec.Framework = &jobset.Framework{Name: jobset.JAX}
+				return js
+			}(),
+			want: field.Forbidden(field.NewPath("spec", "framework"), "framework can only be set when spec.network.enableDNSHostnames is true"),
+		},
+		{
+			name: "replicated job framework requires dns hostnames",
+			js: func() *jobset.JobSet {
+				js := makeNetworkJobSet(&jobset.Network{EnableDNSHostnames: ptr.To(false)}, nil)
+				js.Spec.ReplicatedJobs[0].Framework = &jobset.Framework{Name: jobset.TensorFlow}
+				return js
+			}(),
+			want: field.Forbidden(field.NewPath("spec", "replicatedJobs").Index(0).Child("framework"), "framework can only be set when spec.network.enableDNSHostnames is true"),
+		},
+		{
+			name: "tensorflow replicated job named after a task type",
+			js: func() *jobset.JobSet {
+				js := makeNetworkJobSet(&jobset.Network{EnableDNSHostnames: ptr.To(true)}, nil)
+				js.Spec.ReplicatedJobs[0].Name = "worker"
+				js.Spec.ReplicatedJobs[0].Template.Spec.CompletionMode = ptr.To(batchv1.IndexedCompletion)
+				js.Spec.ReplicatedJobs[0].Framework = &jobset.Framework{Name: jobset.TensorFlow}
+				return js
+			}(),
+		},
+		{
+			name: "tensorflow replicated job must be named after a task type",
+			js: func() *jobset.JobSet {
+				js := makeNetworkJobSet(&jobset.Network{EnableDNSHostnames: ptr.To(true)}, nil)
+				js.Spec.ReplicatedJobs[0].Template.Spec.CompletionMode = ptr.To(batchv1.IndexedCompletion)
+				js.Spec.Framework = &jobset.Framework{Name: jobset.TensorFlow}
+				return js
+			}(),
+			want: field.NotSupported(field.NewPath("spec", "replicatedJobs").Index(0).Child("name"), "rjob-0", []string{"chief", "evaluator", "ps", "worker"}),
+		},
+		{
+			name: "framework requires the indexed completion mode",
+			js: func() *jobset.JobSet {
+				js := makeNetworkJobSet(&jobset.Network{EnableDNSHostnames: ptr.To(true)}, nil)
+				js.Spec.ReplicatedJobs[0].Template.Spec.CompletionMode = ptr.To(batchv1.NonIndexedCompletion)
+				js.Spec.Framework = &jobset.Framework{Name: jobset.MPI}
+				return js
+			}(),
+			want: field.Invalid(field.NewPath("spec", "replicatedJobs").Index(0).Child("template", "spec", "completionMode"), batchv1.NonIndexedCompletion, "completionMode must be Indexed when a framework is set"),
 		},
 	}
 
@@ -2037,19 +3058,24 @@ func TestValidateCreate(t *testing.T) {
 		jobsetControllerNameTests,
 		failurePolicyTests,
 		dependsOnTests,
+		scaleTests,
+		networkTests,
+		frameworkTests,
 	}
 	var testCases []validationTestCase
 	for _, testGroup := range testGroups {
 		testCases = append(testCases, testGroup...)
 	}
 
-	fakeClient := fake.NewFakeClient()
-	webhook, err := NewJobSetWebhook(fakeClient)
+	webhook, err := NewJobSetWebhook(newFakeClient())
 	if err != nil {
 		t.Fatalf("error creating jobset webhook: %v", err)
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
+			for f, v := range tc.featureGates {
+				features.SetFeatureGateDuringTest(t, f, v)
+			}
 			_, err := webhook.ValidateCreate(context.TODO(), tc.js.DeepCopyObject())
 			if err != nil && tc.want != nil {
 				assert.Contains(t, err.Error(), tc.want.Error())
@@ -2406,6 +3432,229 @@ func TestValidateUpdate(t *testing.T) {
 				field.Invalid(field.NewPath("spec").Child("replicatedJobs"), "", "field is immutable"),
 			}.ToAggregate(),
 		},
+		{
+			name: "replicated job replicas can be updated for running jobset",
+			js: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:     "test-jobset-replicated-job-0",
+							Replicas: 4,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Parallelism: ptr.To[int32](2),
+								},
+							},
+						},
+					},
+				},
+			},
+			oldJs: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:     "test-jobset-replicated-job-0",
+							Replicas: 2,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Parallelism: ptr.To[int32](2),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "replicas of the last replicated job can be updated for running jobset",
+			js: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						validReplicatedJobs[0],
+						{
+							Name:     "test-jobset-replicated-job-1",
+							Replicas: 3,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Parallelism: ptr.To[int32](1),
+								},
+							},
+						},
+					},
+				},
+			},
+			oldJs: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					ReplicatedJobs: validReplicatedJobs,
+				},
+			},
+		},
+		{
+			name: "replicas of a replicated job followed by other replicated jobs cannot be updated",
+			js: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:     "test-jobset-replicated-job-0",
+							Replicas: 3,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Parallelism: ptr.To[int32](1),
+								},
+							},
+						},
+						validReplicatedJobs[1],
+					},
+				},
+			},
+			oldJs: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					ReplicatedJobs: validReplicatedJobs,
+				},
+			},
+			want: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "replicatedJobs").Index(0).Child("replicas"), "only the replicas of the last replicated job can be updated"),
+			}.ToAggregate(),
+		},
+		{
+			name: "replicated job can be suspended for running jobset",
+			js: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:     "test-jobset-replicated-job-0",
+							Replicas: 2,
+							Suspend:  ptr.To(true),
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Parallelism: ptr.To[int32](2),
+								},
+							},
+						},
+					},
+				},
+			},
+			oldJs: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:     "test-jobset-replicated-job-0",
+							Replicas: 2,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Parallelism: ptr.To[int32](2),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "replicated job replicas cannot be scaled down below minSucceeded",
+			js: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					SuccessPolicy: &jobset.SuccessPolicy{Operator: jobset.OperatorAtLeast, MinSucceeded: ptr.To[int32](2)},
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:     "test-jobset-replicated-job-0",
+							Replicas: 1,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Parallelism: ptr.To[int32](2),
+								},
+							},
+						},
+					},
+				},
+			},
+			oldJs: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					SuccessPolicy: &jobset.SuccessPolicy{Operator: jobset.OperatorAtLeast, MinSucceeded: ptr.To[int32](2)},
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:     "test-jobset-replicated-job-0",
+							Replicas: 2,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Parallelism: ptr.To[int32](2),
+								},
+							},
+						},
+					},
+				},
+			},
+			want: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "successPolicy", "minSucceeded"), "", "must not exceed the number of replicas of the target replicated jobs (1)"),
+			}.ToAggregate(),
+		},
+		{
+			name: "scale replicas can be updated without the replicas of the target replicated job",
+			js: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					ReplicatedJobs: validReplicatedJobs,
+					Scale:          &jobset.ReplicatedJobScale{ReplicatedJob: "test-jobset-replicated-job-1", Replicas: ptr.To[int32](3)},
+				},
+			},
+			oldJs: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					ReplicatedJobs: validReplicatedJobs,
+					Scale:          &jobset.ReplicatedJobScale{ReplicatedJob: "test-jobset-replicated-job-1", Replicas: ptr.To[int32](1)},
+				},
+			},
+		},
+		{
+			name: "replicas of the target replicated job must match scale replicas",
+			js: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:     "test-jobset-replicated-job-0",
+							Replicas: 3,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Parallelism: ptr.To[int32](1),
+								},
+							},
+						},
+					},
+					Scale: &jobset.ReplicatedJobScale{ReplicatedJob: "test-jobset-replicated-job-0", Replicas: ptr.To[int32](2)},
+				},
+			},
+			oldJs: &jobset.JobSet{
+				ObjectMeta: validObjectMeta,
+				Spec: jobset.JobSetSpec{
+					ReplicatedJobs: []jobset.ReplicatedJob{
+						{
+							Name:     "test-jobset-replicated-job-0",
+							Replicas: 1,
+							Template: batchv1.JobTemplateSpec{
+								Spec: batchv1.JobSpec{
+									Parallelism: ptr.To[int32](1),
+								},
+							},
+						},
+					},
+					Scale: &jobset.ReplicatedJobScale{ReplicatedJob: "test-jobset-replicated-job-0", Replicas: ptr.To[int32](2)},
+				},
+			},
+			want: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "scale", "replicas"), "", "must match the replicas of replicated job 'test-jobset-replicated-job-0' (3)"),
+			}.ToAggregate(),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2423,3 +3672,110 @@ func TestValidateUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateNetworkGroup(t *testing.T) {
+	makeJobSet := func(name string, network *jobset.Network) *jobset.JobSet {
+		return &jobset.JobSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+			},
+			Spec: jobset.JobSetSpec{
+				Network: network,
+			},
+		}
+	}
+	networkPath := field.NewPath("spec", "network")
+
+	tests := []struct {
+		name     string
+		existing []client.Object
+		js       *jobset.JobSet
+		want     []error
+	}{
+		{
+			name: "first jobset of a network group",
+			js:   makeJobSet("ps", &jobset.Network{EnableDNSHostnames: ptr.To(true), Subdomain: "shared", Group: "training"}),
+		},
+		{
+			name: "jobset joins the network group of another jobset",
+			existing: []client.Object{
+				makeJobSet("ps", &jobset.Network{EnableDNSHostnames: ptr.To(true), Subdomain: "shared", Group: "training"}),
+			},
+			js: makeJobSet("workers", &jobset.Network{EnableDNSHostnames: ptr.To(true), Subdomain: "shared", Group: "training"}),
+		},
+		{
+			name: "jobsets outside of network groups are not validated against each other",
+			existing: []client.Object{
+				makeJobSet("ps", &jobset.Network{EnableDNSHostnames: ptr.To(true), Subdomain: "shared"}),
+			},
+			js: makeJobSet("workers", &jobset.Network{EnableDNSHostnames: ptr.To(true), Subdomain: "shared"}),
+		},
+		{
+			name: "network group without subdomain",
+			js:   makeJobSet("ps", &jobset.Network{EnableDNSHostnames: ptr.To(true), Group: "training"}),
+			want: []error{
+				field.Required(networkPath.Child("subdomain"), "subdomain must be set when group is set"),
+			},
+		},
+		{
+			name: "network group without dns hostnames",
+			js:   makeJobSet("ps", &jobset.Network{EnableDNSHostnames: ptr.To(false), Subdomain: "shared", Group: "training"}),
+			want: []error{
+				field.Forbidden(networkPath.Child("group"), "group can only be set when enableDNSHostnames is true"),
+			},
+		},
+		{
+			name: "subdomain is used by a jobset outside of the network group",
+			existing: []client.Object{
+				makeJobSet("ps", &jobset.Network{EnableDNSHostnames: ptr.To(true), Subdomain: "shared"}),
+			},
+			js: makeJobSet("workers", &jobset.Network{EnableDNSHostnames: ptr.To(true), Subdomain: "shared", Group: "training"}),
+			want: []error{
+				field.Invalid(networkPath.Child("subdomain"), "shared", `subdomain is used by JobSet "ps" which is not in network group "training"`),
+			},
+		},
+		{
+			name: "subdomain is used by a jobset of another network group",
+			existing: []client.Object{
+				makeJobSet("ps", &jobset.Network{EnableDNSHostnames: ptr.To(true), Subdomain: "shared", Group: "inference"}),
+			},
+			js: makeJobSet("workers", &jobset.Network{EnableDNSHostnames: ptr.To(true), Subdomain: "shared"}),
+			want: []error{
+				field.Invalid(networkPath.Child("subdomain"), "shared", `subdomain is used by JobSet "ps" of network group "inference"`),
+			},
+		},
+		{
+			name: "ports differ within the network group",
+			existing: []client.Object{
+				makeJobSet("ps", &jobset.Network{EnableDNSHostnames: ptr.To(true), Subdomain: "shared", Group: "training",
+					Ports: []jobset.NetworkPort{{Name: "grpc", Port: 8470}}}),
+			},
+			js: makeJobSet("workers", &jobset.Network{EnableDNSHostnames: ptr.To(true), Subdomain: "shared", Group: "training"}),
+			want: []error{
+				field.Invalid(networkPath.Child("ports"), nil, `ports must match JobSet "ps" of network group "training"`),
+			},
+		},
+		{
+			name: "publishNotReadyAddresses differs within the network group",
+			existing: []client.Object{
+				makeJobSet("ps", &jobset.Network{EnableDNSHostnames: ptr.To(true), Subdomain: "shared", Group: "training"}),
+			},
+			js: makeJobSet("workers", &jobset.Network{EnableDNSHostnames: ptr.To(true), Subdomain: "shared", Group: "training", PublishNotReadyAddresses: ptr.To(false)}),
+			want: []error{
+				field.Invalid(networkPath.Child("publishNotReadyAddresses"), false, `publishNotReadyAddresses must match JobSet "ps" of network group "training"`),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			webhook, err := NewJobSetWebhook(newFakeClient(tc.existing...))
+			assert.Nil(t, err)
+			got := webhook.validateNetworkGroup(context.TODO(), tc.js)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreFields(field.Error{}, "BadValue")); diff != "" {
+				t.Errorf("validateNetworkGroup() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
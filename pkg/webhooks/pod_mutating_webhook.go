@@ -16,6 +16,8 @@ package webhooks
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -28,6 +30,7 @@ import (
 
 	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 	"sigs.k8s.io/jobset/pkg/constants"
+	"sigs.k8s.io/jobset/pkg/controllers"
 )
 
 // +kubebuilder:webhook:path=/mutate--v1-pod,mutating=true,failurePolicy=fail,groups="",resources=pods,verbs=create,versions=v1,name=mpod.kb.io,sideEffects=None,admissionReviewVersions=v1
@@ -67,6 +70,13 @@ func (p *podWebhook) Default(ctx context.Context, obj runtime.Object) error {
 	if _, isJobSetPod := pod.Annotations[jobset.JobSetNameKey]; !isJobSetPod {
 		return nil
 	}
+	// If the ReplicatedJob of this pod uses a framework preset, inject its rendezvous environment
+	// variables, which depend on the completion index of the pod.
+	if _, usingFramework := pod.Annotations[jobset.FrameworkKey]; usingFramework {
+		if err := p.injectFrameworkEnv(ctx, pod); err != nil {
+			return err
+		}
+	}
 	// If the parent JobSet is using the node selector exclusive placement strategy (running
 	// the hack/label_nodes.py script beforehand), skip it.
 	if _, usingNodeSelectorStrategy := pod.Annotations[jobset.NodeSelectorStrategyKey]; usingNodeSelectorStrategy {
@@ -88,6 +98,37 @@ func (p *podWebhook) Default(ctx context.Context, obj runtime.Object) error {
 	return nil
 }
 
+// injectFrameworkEnv injects the rendezvous environment variables of the framework preset of the
+// ReplicatedJob of the pod into its containers, computed from its job index and completion index,
+// and from the replicas of its framework world when its Job was created.
+func (p *podWebhook) injectFrameworkEnv(ctx context.Context, pod *corev1.Pod) error {
+	var js jobset.JobSet
+	if err := p.client.Get(ctx, types.NamespacedName{Name: pod.Annotations[jobset.JobSetNameKey], Namespace: pod.Namespace}, &js); err != nil {
+		return err
+	}
+	rjobName := pod.Annotations[jobset.ReplicatedJobNameKey]
+	rjobIdx := slices.IndexFunc(js.Spec.ReplicatedJobs, func(rjob jobset.ReplicatedJob) bool { return rjob.Name == rjobName })
+	if rjobIdx < 0 {
+		return fmt.Errorf("replicated job %q not found in jobset %q", rjobName, js.Name)
+	}
+	jobIdx, err := strconv.Atoi(pod.Annotations[jobset.JobIndexKey])
+	if err != nil {
+		return fmt.Errorf("invalid pod annotation %s: %w", jobset.JobIndexKey, err)
+	}
+	podIdx, err := strconv.Atoi(pod.Annotations[batchv1.JobCompletionIndexAnnotation])
+	if err != nil {
+		return fmt.Errorf("invalid pod annotation %s: %w", batchv1.JobCompletionIndexAnnotation, err)
+	}
+	var replicas map[string]int32
+	if value, ok := pod.Annotations[jobset.FrameworkReplicasKey]; ok {
+		if replicas, err = controllers.ParseFrameworkReplicas(value); err != nil {
+			return fmt.Errorf("invalid pod annotation %s: %w", jobset.FrameworkReplicasKey, err)
+		}
+	}
+	controllers.InjectEnv(&pod.Spec, controllers.FrameworkEnv(&js, &js.Spec.ReplicatedJobs[rjobIdx], replicas, jobIdx, podIdx))
+	return nil
+}
+
 // patchPod will mutate pods in the following ways:
 //  1. For leader pods (job completion index 0), pod affinities/anti-affinities for
 //     exclusive placement per topology are injected.
@@ -31,7 +31,9 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	jobset "sigs.k8s.io/jobset/api/jobset/v1alpha2"
 	"sigs.k8s.io/jobset/pkg/controllers"
+	testutils "sigs.k8s.io/jobset/pkg/util/testing"
 )
 
 func TestDefault(t *testing.T) {
@@ -404,12 +406,136 @@ func TestDefault(t *testing.T) {
 			wantPod: nil,
 			wantErr: apierrors.NewNotFound(schema.GroupResource{Group: "", Resource: "nodes"}, "node-a"),
 		},
+		{
+			name: "jobset pod with a framework preset",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "p",
+					Namespace: "default",
+					Annotations: map[string]string{
+						"jobset.sigs.k8s.io/jobset-name":           "js",
+						"jobset.sigs.k8s.io/replicatedjob-name":    "workers",
+						"jobset.sigs.k8s.io/job-index":             "1",
+						"batch.kubernetes.io/job-completion-index": "0",
+						"jobset.sigs.k8s.io/framework":             "JAX",
+					},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "c"}},
+				},
+			},
+			existingObjs: []runtime.Object{
+				testutils.MakeJobSet("js", "default").
+					Framework(&jobset.Framework{Name: jobset.JAX}).
+					ReplicatedJob(testutils.MakeReplicatedJob("workers").
+						Job(testutils.MakeJobTemplate("workers", "default").Obj()).
+						Replicas(2).
+						Obj()).
+					Obj(),
+			},
+			wantPod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "p",
+					Namespace: "default",
+					Annotations: map[string]string{
+						"jobset.sigs.k8s.io/jobset-name":           "js",
+						"jobset.sigs.k8s.io/replicatedjob-name":    "workers",
+						"jobset.sigs.k8s.io/job-index":             "1",
+						"batch.kubernetes.io/job-completion-index": "0",
+						"jobset.sigs.k8s.io/framework":             "JAX",
+					},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name: "c",
+						Env: []corev1.EnvVar{
+							{Name: "JAX_COORDINATOR_ADDRESS", Value: "js-workers-0-0.js:1234"},
+							{Name: "JAX_NUM_PROCESSES", Value: "2"},
+							{Name: "JAX_PROCESS_ID", Value: "1"},
+						},
+					}},
+				},
+			},
+		},
+		{
+			name: "jobset pod with a framework preset, jobset scaled after the job was created",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "p",
+					Namespace: "default",
+					Annotations: map[string]string{
+						"jobset.sigs.k8s.io/jobset-name":           "js",
+						"jobset.sigs.k8s.io/replicatedjob-name":    "workers",
+						"jobset.sigs.k8s.io/job-index":             "1",
+						"batch.kubernetes.io/job-completion-index": "0",
+						"jobset.sigs.k8s.io/framework":             "JAX",
+						"jobset.sigs.k8s.io/framework-replicas":    "workers=2",
+					},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "c"}},
+				},
+			},
+			existingObjs: []runtime.Object{
+				testutils.MakeJobSet("js", "default").
+					Framework(&jobset.Framework{Name: jobset.JAX}).
+					ReplicatedJob(testutils.MakeReplicatedJob("workers").
+						Job(testutils.MakeJobTemplate("workers", "default").Obj()).
+						Replicas(4).
+						Obj()).
+					Obj(),
+			},
+			wantPod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "p",
+					Namespace: "default",
+					Annotations: map[string]string{
+						"jobset.sigs.k8s.io/jobset-name":           "js",
+						"jobset.sigs.k8s.io/replicatedjob-name":    "workers",
+						"jobset.sigs.k8s.io/job-index":             "1",
+						"batch.kubernetes.io/job-completion-index": "0",
+						"jobset.sigs.k8s.io/framework":             "JAX",
+						"jobset.sigs.k8s.io/framework-replicas":    "workers=2",
+					},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name: "c",
+						Env: []corev1.EnvVar{
+							{Name: "JAX_COORDINATOR_ADDRESS", Value: "js-workers-0-0.js:1234"},
+							{Name: "JAX_NUM_PROCESSES", Value: "2"},
+							{Name: "JAX_PROCESS_ID", Value: "1"},
+						},
+					}},
+				},
+			},
+		},
+		{
+			name: "jobset pod with a framework preset, jobset not found",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "p",
+					Namespace: "default",
+					Annotations: map[string]string{
+						"jobset.sigs.k8s.io/jobset-name":           "js",
+						"jobset.sigs.k8s.io/replicatedjob-name":    "workers",
+						"jobset.sigs.k8s.io/job-index":             "1",
+						"batch.kubernetes.io/job-completion-index": "0",
+						"jobset.sigs.k8s.io/framework":             "JAX",
+					},
+				},
+			},
+			wantErr: apierrors.NewNotFound(schema.GroupResource{Group: "jobset.x-k8s.io", Resource: "jobsets"}, "js"),
+		},
 	}
 
 	scheme := runtime.NewScheme()
 	if err := corev1.AddToScheme(scheme); err != nil {
 		t.Fatalf("failed to add corev1 to scheme: %v", err)
 	}
+	if err := jobset.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add jobset to scheme: %v", err)
+	}
 
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
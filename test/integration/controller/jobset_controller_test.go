@@ -29,6 +29,8 @@ import (
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/types"
@@ -2567,6 +2569,156 @@ var _ = ginkgo.Describe("JobSet controller", func() {
 			}, timeout, interval).Should(gomega.BeTrue())
 		})
 	})
+
+	ginkgo.When("a Job with the name of a child Job already exists", func() {
+		var ns *corev1.Namespace
+
+		ginkgo.BeforeEach(func() {
+			ns = &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: "jobset-ns-",
+				},
+			}
+			ginkgo.By("creating namespace")
+			gomega.Expect(k8sClient.Create(ctx, ns)).To(gomega.Succeed())
+		})
+
+		ginkgo.AfterEach(func() {
+			gomega.Expect(testutil.DeleteNamespace(ctx, k8sClient, ns)).To(gomega.Succeed())
+		})
+
+		makeConflictingJobSet := func() *jobset.JobSet {
+			return testing.MakeJobSet("conflict-jobset", ns.Name).
+				ReplicatedJob(testing.MakeReplicatedJob("replicated-job-a").
+					Job(testing.MakeJobTemplate("test-job", ns.Name).PodSpec(testing.TestPodSpec).Obj()).
+					Replicas(1).
+					Obj()).
+				Obj()
+		}
+		makeExistingJob := func(labels map[string]string) *batchv1.Job {
+			return &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "conflict-jobset-replicated-job-a-0",
+					Namespace: ns.Name,
+					Labels:    labels,
+				},
+				Spec: batchv1.JobSpec{
+					Template: corev1.PodTemplateSpec{Spec: testing.TestPodSpec},
+				},
+			}
+		}
+
+		ginkgo.It("should adopt an orphaned job with matching labels", func() {
+			job := makeExistingJob(map[string]string{
+				jobset.JobSetNameKey:        "conflict-jobset",
+				jobset.ReplicatedJobNameKey: "replicated-job-a",
+				jobset.JobIndexKey:          "0",
+				constants.RestartsKey:       "0",
+			})
+			ginkgo.By("creating the orphaned job")
+			gomega.Expect(k8sClient.Create(ctx, job)).Should(gomega.Succeed())
+
+			js := makeConflictingJobSet()
+			ginkgo.By("creating the jobset")
+			gomega.Expect(k8sClient.Create(ctx, js)).Should(gomega.Succeed())
+
+			ginkgo.By("checking that the job is adopted by the jobset")
+			gomega.Eventually(func() (bool, error) {
+				var fetched batchv1.Job
+				if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(job), &fetched); err != nil {
+					return false, err
+				}
+				owner := metav1.GetControllerOf(&fetched)
+				return owner != nil && owner.UID == js.UID, nil
+			}, timeout, interval).Should(gomega.BeTrue())
+			gomega.Eventually(testutil.NumJobs, timeout, interval).WithArguments(ctx, k8sClient, js).Should(gomega.Equal(testutil.NumExpectedJobs(js)))
+
+			ginkgo.By("checking that the jobset is not failed")
+			gomega.Consistently(func() (string, error) {
+				var fetched jobset.JobSet
+				if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(js), &fetched); err != nil {
+					return "", err
+				}
+				return fetched.Status.TerminalState, nil
+			}, timeout, interval).Should(gomega.BeEmpty())
+		})
+
+		ginkgo.It("should fail the jobset when the job cannot be adopted", func() {
+			job := makeExistingJob(map[string]string{"app": "unrelated"})
+			ginkgo.By("creating the unrelated job")
+			gomega.Expect(k8sClient.Create(ctx, job)).Should(gomega.Succeed())
+
+			js := makeConflictingJobSet()
+			ginkgo.By("creating the jobset")
+			gomega.Expect(k8sClient.Create(ctx, js)).Should(gomega.Succeed())
+
+			testutil.JobSetFailed(ctx, k8sClient, js, timeout)
+
+			ginkgo.By("checking that the jobset failed because of the job name conflict")
+			var fetched jobset.JobSet
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(js), &fetched)).Should(gomega.Succeed())
+			failed := apimeta.FindStatusCondition(fetched.Status.Conditions, string(jobset.JobSetFailed))
+			gomega.Expect(failed).NotTo(gomega.BeNil())
+			gomega.Expect(failed.Reason).To(gomega.Equal(constants.JobNameConflictReason))
+
+			ginkgo.By("checking that the unrelated job is not adopted")
+			var fetchedJob batchv1.Job
+			gomega.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(job), &fetchedJob)).Should(gomega.Succeed())
+			gomega.Expect(metav1.GetControllerOf(&fetchedJob)).To(gomega.BeNil())
+		})
+
+		ginkgo.It("should wait for the jobs of a deleted jobset with the same name to be garbage collected", func() {
+			previous := makeConflictingJobSet()
+			ginkgo.By("creating the jobset")
+			gomega.Expect(k8sClient.Create(ctx, previous)).Should(gomega.Succeed())
+			gomega.Eventually(testutil.NumJobs, timeout, interval).WithArguments(ctx, k8sClient, previous).Should(gomega.Equal(testutil.NumExpectedJobs(previous)))
+
+			ginkgo.By("deleting the jobset")
+			gomega.Expect(k8sClient.Delete(ctx, previous)).Should(gomega.Succeed())
+			gomega.Eventually(func() bool {
+				return apierrors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(previous), &jobset.JobSet{}))
+			}, timeout, interval).Should(gomega.BeTrue())
+
+			js := makeConflictingJobSet()
+			ginkgo.By("recreating the jobset while the jobs of the deleted jobset still exist")
+			gomega.Expect(k8sClient.Create(ctx, js)).Should(gomega.Succeed())
+
+			ginkgo.By("checking that the jobset is not failed")
+			gomega.Consistently(func() (string, error) {
+				var fetched jobset.JobSet
+				if err := k8sClient.Get(ctx, client.ObjectKeyFromObject(js), &fetched); err != nil {
+					return "", err
+				}
+				return fetched.Status.TerminalState, nil
+			}, timeout, interval).Should(gomega.BeEmpty())
+
+			// The garbage collector does not run in the test environment, so the jobs of the
+			// deleted jobset are deleted explicitly.
+			ginkgo.By("garbage collecting the jobs of the deleted jobset")
+			var jobList batchv1.JobList
+			gomega.Expect(k8sClient.List(ctx, &jobList, client.InNamespace(ns.Name))).Should(gomega.Succeed())
+			for i := range jobList.Items {
+				if owner := metav1.GetControllerOf(&jobList.Items[i]); owner != nil && owner.UID == previous.UID {
+					gomega.Expect(k8sClient.Delete(ctx, &jobList.Items[i], client.PropagationPolicy(metav1.DeletePropagationBackground))).Should(gomega.Succeed())
+				}
+			}
+
+			ginkgo.By("checking that the jobs of the recreated jobset are created")
+			gomega.Eventually(func() (int, error) {
+				var jobList batchv1.JobList
+				if err := k8sClient.List(ctx, &jobList, client.InNamespace(ns.Name)); err != nil {
+					return 0, err
+				}
+				owned := 0
+				for i := range jobList.Items {
+					if metav1.IsControlledBy(&jobList.Items[i], js) {
+						owned++
+					}
+				}
+				return owned, nil
+			}, timeout, interval).Should(gomega.Equal(testutil.NumExpectedJobs(js)))
+		})
+	})
 }) // end of Describe
 
 func makeAllJobsReady(jl *batchv1.JobList) {
@@ -468,7 +468,7 @@ var _ = ginkgo.Describe("jobset webhook defaulting", func() {
 			},
 			jobSetCreationShouldFail: true,
 		}),
-		ginkgo.Entry("DependsOn can't be set for the first ReplicatedJob", &testCase{
+		ginkgo.Entry("DependsOn can't form a dependency cycle", &testCase{
 			makeJobSet: func(ns *corev1.Namespace) *testing.JobSetWrapper {
 				return testing.MakeJobSet("depends-on", ns.Name).
 					ReplicatedJob(testing.MakeReplicatedJob("rjob-1").
@@ -500,7 +500,7 @@ var _ = ginkgo.Describe("jobset webhook defaulting", func() {
 						DependsOn([]jobset.DependsOn{
 							{
 								Name:   "rjob-1",
-								Status: "Failed",
+								Status: "Invalid",
 							},
 						}).
 						Obj())